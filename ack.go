@@ -0,0 +1,240 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Ack is an acknowledgement of a down host. Alerts for the host are suppressed until it recovers
+type Ack struct {
+	Host    string    `json:"host"`
+	User    string    `json:"user"`
+	Comment string    `json:"comment,omitempty"`
+	Time    time.Time `json:"time"`
+}
+
+// Silence suppresses alerts for a host or category until Expires
+type Silence struct {
+	ID       string    `json:"id"`
+	Host     string    `json:"host,omitempty"`
+	Category string    `json:"category,omitempty"`
+	User     string    `json:"user"`
+	Comment  string    `json:"comment,omitempty"`
+	Time     time.Time `json:"time"`
+	Expires  time.Time `json:"expires"`
+}
+
+// Covers returns true if the silence applies to host, which is in categories
+func (s *Silence) Covers(host string, categories []string) bool {
+	if s.Host != "" {
+		return s.Host == host
+	}
+	for _, c := range categories {
+		if c == s.Category {
+			return true
+		}
+	}
+	return false
+}
+
+// AckList is the list of current acks and silences
+type AckList struct {
+	Acks     []*Ack     `json:"acks"`
+	Silences []*Silence `json:"silences"`
+}
+
+// ackMessage is an AckList sent to websocket clients
+type ackMessage AckList
+
+// MarshalJSON implements the json.Marshaler interface
+func (m *ackMessage) MarshalJSON() ([]byte, error) {
+	type acks struct {
+		Type     string     `json:"t"`
+		Acks     []*Ack     `json:"a"`
+		Silences []*Silence `json:"s"`
+	}
+	return json.Marshal(&acks{Type: "a", Acks: m.Acks, Silences: m.Silences})
+}
+
+// Errors returned by Acks
+var (
+	ErrAckNotFound     = errors.New("ack not found")
+	ErrSilenceNotFound = errors.New("silence not found")
+)
+
+// Acks stores acks and silences. If path isn't empty, they're loaded from and saved to the JSON file at path
+type Acks struct {
+	path     string
+	acks     map[string]*Ack
+	silences map[string]*Silence
+	mu       *sync.RWMutex
+}
+
+// NewAcks returns a new *Acks with the acks and silences in the file at path, if it exists
+func NewAcks(path string) (*Acks, error) {
+	a := &Acks{path: path, acks: make(map[string]*Ack), silences: make(map[string]*Silence), mu: new(sync.RWMutex)}
+	if path == "" {
+		return a, nil
+	}
+
+	buf, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return a, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("could not read acks file: %w", err)
+	}
+
+	list := new(AckList)
+	if err = json.Unmarshal(buf, list); err != nil {
+		return nil, fmt.Errorf("could not parse acks file: %w", err)
+	}
+	for _, ack := range list.Acks {
+		a.acks[ack.Host] = ack
+	}
+	for _, s := range list.Silences {
+		a.silences[s.ID] = s
+	}
+
+	return a, nil
+}
+
+// list returns the current acks and silences, sorted by host and expiration. The caller must hold a lock
+func (a *Acks) list() *AckList {
+	list := &AckList{Acks: make([]*Ack, 0, len(a.acks)), Silences: make([]*Silence, 0, len(a.silences))}
+	for _, ack := range a.acks {
+		list.Acks = append(list.Acks, ack)
+	}
+	for _, s := range a.silences {
+		list.Silences = append(list.Silences, s)
+	}
+	sort.Slice(list.Acks, func(i, j int) bool { return list.Acks[i].Host < list.Acks[j].Host })
+	sort.Slice(list.Silences, func(i, j int) bool { return list.Silences[i].Expires.Before(list.Silences[j].Expires) })
+	return list
+}
+
+// save writes the acks and silences to the file. The caller must hold a lock
+func (a *Acks) save() error {
+	if a.path == "" {
+		return nil
+	}
+
+	buf, err := json.MarshalIndent(a.list(), "", "  ")
+	if err != nil {
+		return fmt.Errorf("could not marshal acks: %w", err)
+	}
+
+	tmp := a.path + ".tmp"
+	if err = os.WriteFile(tmp, buf, 0644); err != nil {
+		return fmt.Errorf("could not write acks file: %w", err)
+	}
+	if err = os.Rename(tmp, a.path); err != nil {
+		return fmt.Errorf("could not rename acks file: %w", err)
+	}
+
+	return nil
+}
+
+// List returns the current acks and silences
+func (a *Acks) List() *AckList {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.list()
+}
+
+// Message returns the current acks and silences as a websocket message
+func (a *Acks) Message() json.Marshaler {
+	return (*ackMessage)(a.List())
+}
+
+// Ack acknowledges host, replacing any existing ack
+func (a *Acks) Ack(ack *Ack) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.acks[ack.Host] = ack
+	return a.save()
+}
+
+// Unack removes the ack for host
+func (a *Acks) Unack(host string) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if _, ok := a.acks[host]; !ok {
+		return ErrAckNotFound
+	}
+	delete(a.acks, host)
+	return a.save()
+}
+
+// Silence adds s with a new ID
+func (a *Acks) Silence(s *Silence) error {
+	id, err := newID()
+	if err != nil {
+		return err
+	}
+	s.ID = id
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.silences[s.ID] = s
+	return a.save()
+}
+
+// Unsilence removes the silence with the given id
+func (a *Acks) Unsilence(id string) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if _, ok := a.silences[id]; !ok {
+		return ErrSilenceNotFound
+	}
+	delete(a.silences, id)
+	return a.save()
+}
+
+// Update removes expired silences and the acks of hosts that have recovered,
+// sets Suppressed on transitions that are acked or silenced, and returns true if anything was removed
+func (a *Acks) Update(transitions []*Transition) (bool, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	changed := false
+	now := time.Now()
+	for id, s := range a.silences {
+		if !now.Before(s.Expires) {
+			delete(a.silences, id)
+			changed = true
+		}
+	}
+
+	for _, t := range transitions {
+		for _, s := range a.silences {
+			if s.Covers(t.Hostname, t.Categories) {
+				t.Suppressed = true
+			}
+		}
+		if _, ok := a.acks[t.Hostname]; !ok {
+			continue
+		}
+		// recoveries are still sent so whoever acked the host knows
+		if t.Status == StatusUp {
+			delete(a.acks, t.Hostname)
+			changed = true
+			continue
+		}
+		t.Suppressed = true
+	}
+
+	if !changed {
+		return false, nil
+	}
+	return true, a.save()
+}
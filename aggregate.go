@@ -0,0 +1,92 @@
+package main
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+// CategoryCounts are the amount of hosts with each status in a category and its nested categories.
+// Status is the worst status of the hosts that aren't in maintenance, so a collapsed category still shows a down host
+type CategoryCounts struct {
+	Status      Status `json:"st"`
+	Up          int    `json:"up"`
+	Degraded    int    `json:"degraded"`
+	Down        int    `json:"down"`
+	Unreachable int    `json:"unreachable"`
+	Unknown     int    `json:"unknown"`
+	Maintenance int    `json:"m"`
+}
+
+// add counts a host with status st
+func (c *CategoryCounts) add(st Status, maintenance bool) {
+	switch st {
+	case StatusUp:
+		c.Up++
+	case StatusDegraded:
+		c.Degraded++
+	case StatusDown:
+		c.Down++
+	case StatusUnreachable:
+		c.Unreachable++
+	default:
+		c.Unknown++
+	}
+
+	if maintenance {
+		c.Maintenance++
+		return
+	}
+	// down is worse than unreachable since it's the root cause
+	if statusRank[st] > statusRank[c.Status] || (st == StatusDown && c.Status == StatusUnreachable) {
+		c.Status = st
+	}
+}
+
+// Aggregates are the host counts of every category, keyed by category path
+type Aggregates map[string]*CategoryCounts
+
+// MarshalJSON implements the json.Marshaler interface
+func (a Aggregates) MarshalJSON() ([]byte, error) {
+	type aggregates map[string]*CategoryCounts
+	return json.Marshal(&struct {
+		Type       string     `json:"t"`
+		Aggregates aggregates `json:"g"`
+	}{Type: "g", Aggregates: aggregates(a)})
+}
+
+// Aggregates returns a copy of the host counts of every category
+func (s *State) Aggregates() Aggregates {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	a := make(Aggregates, len(s.aggregates))
+	for path, counts := range s.aggregates {
+		c := *counts
+		a[path] = &c
+	}
+	return a
+}
+
+// updateAggregates recounts the hosts in every category and broadcasts the counts if they changed. The caller must hold the write lock
+func (s *State) updateAggregates() {
+	a := make(Aggregates)
+	for _, c := range s.schema.Categories() {
+		a[c.Path] = &CategoryCounts{Status: StatusUnknown}
+	}
+
+	for host, categories := range s.hostCategories() {
+		st, maintenance := StatusUnknown, false
+		if hs, ok := s.hostStates[host]; ok {
+			st, maintenance = hs.Status, hs.Maintenance
+		}
+		for _, path := range categories {
+			a[path].add(st, maintenance)
+		}
+	}
+
+	if reflect.DeepEqual(a, s.aggregates) {
+		return
+	}
+	s.aggregates = a
+	s.broadcast(a)
+}
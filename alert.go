@@ -0,0 +1,161 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Alert is a host status transition sent to a notifier. Matched is the host's categories that matched the notifier's routes,
+// and Category is the first of them. Name, Description, Owner, Location and Tags are the host's attributes from the schema
+type Alert struct {
+	Host       string        `json:"host"`
+	Category   string        `json:"category"`
+	Categories []string      `json:"categories"`
+	Matched    []string      `json:"matched"`
+	IPs        []string      `json:"ips"`
+	State      Status        `json:"state"`
+	Previous   Status        `json:"previous"`
+	Time       time.Time     `json:"time"`
+	Duration   time.Duration `json:"-"`
+	Flapping   bool          `json:"flapping"`
+	Cause      string        `json:"cause,omitempty"`
+
+	Name        string   `json:"name,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Owner       string   `json:"owner,omitempty"`
+	Location    string   `json:"location,omitempty"`
+	Tags        []string `json:"tags,omitempty"`
+}
+
+// MarshalJSON implements the json.Marshaler interface. Duration is encoded in seconds
+func (a *Alert) MarshalJSON() ([]byte, error) {
+	type alert Alert
+	return json.Marshal(&struct {
+		*alert
+		Duration float64 `json:"duration"`
+	}{alert: (*alert)(a), Duration: a.Duration.Seconds()})
+}
+
+// NewAlert returns an Alert for t routed for the matched categories
+func NewAlert(t *Transition, matched []string) *Alert {
+	a := &Alert{
+		Host:       t.Hostname,
+		Categories: t.Categories,
+		Matched:    matched,
+		IPs:        make([]string, 0, len(t.IPs)),
+		State:      t.Status,
+		Previous:   t.Previous,
+		Time:       t.Time,
+		Duration:   t.Duration,
+		Flapping:   t.Flapping,
+		Cause:      t.Cause,
+	}
+	if len(matched) > 0 {
+		a.Category = matched[0]
+	}
+	for _, ip := range t.IPs {
+		a.IPs = append(a.IPs, ip.String())
+	}
+	if t.Host != nil {
+		a.Name, a.Description, a.Owner, a.Location, a.Tags = t.Host.Name, t.Host.Description, t.Host.Owner, t.Host.Location, t.Host.Tags
+	}
+	return a
+}
+
+// Notifier sends alerts
+type Notifier interface {
+	// Name identifies the notifier in logs
+	Name() string
+	// Routes returns the categories the notifier receives alerts for, or nil for all categories
+	Routes() []string
+	// Notify sends alert in the background
+	Notify(alert *Alert)
+}
+
+// AlertConfig configures where alerts are sent. In yaml, it's a mapping with webhooks and email keys
+type AlertConfig struct {
+	Webhooks []*Webhook `yaml:"webhooks"`
+	Email    []*Email   `yaml:"email"`
+}
+
+// LoadAlertConfig reads and validates the alert configuration at path
+func LoadAlertConfig(path string) (*AlertConfig, error) {
+	buf, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read alerts file: %w", err)
+	}
+
+	config := new(AlertConfig)
+	if err = yaml.NewDecoder(bytes.NewBuffer(buf)).Decode(config); err != nil && err != io.EOF {
+		return nil, fmt.Errorf("could not parse alerts file: %w", err)
+	}
+
+	for idx, w := range config.Webhooks {
+		if err = w.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid webhook %d: %w", idx, err)
+		}
+	}
+
+	for idx, e := range config.Email {
+		if err = e.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid email %d: %w", idx, err)
+		}
+	}
+
+	return config, nil
+}
+
+// routes returns the categories in categories that match routes, and whether any matched. If routes is empty, all categories match
+func routes(routes, categories []string) ([]string, bool) {
+	if len(routes) == 0 {
+		return categories, true
+	}
+
+	matched := make([]string, 0)
+	for _, c := range categories {
+		for _, r := range routes {
+			if c == r {
+				matched = append(matched, c)
+				break
+			}
+		}
+	}
+	return matched, len(matched) > 0
+}
+
+// Alerter sends alerts for host status transitions
+type Alerter struct {
+	notifiers []Notifier
+}
+
+// NewAlerter returns a new *Alerter with the given config
+func NewAlerter(config *AlertConfig) *Alerter {
+	notifiers := make([]Notifier, 0, len(config.Webhooks)+len(config.Email))
+	for _, w := range config.Webhooks {
+		notifiers = append(notifiers, w)
+	}
+	for _, e := range config.Email {
+		notifiers = append(notifiers, e)
+	}
+	return &Alerter{notifiers: notifiers}
+}
+
+// Notify sends alerts for transitions in the background. Each notifier is sent at most one alert per transition
+func (a *Alerter) Notify(transitions []*Transition) {
+	for _, t := range transitions {
+		if t.Suppressed {
+			continue
+		}
+		for _, n := range a.notifiers {
+			if matched, ok := routes(n.Routes(), t.Categories); ok {
+				n.Notify(NewAlert(t, matched))
+			}
+		}
+	}
+}
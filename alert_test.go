@@ -0,0 +1,55 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+type testNotifier struct {
+	routes []string
+	alerts []*Alert
+}
+
+func (n *testNotifier) Name() string        { return "test" }
+func (n *testNotifier) Routes() []string    { return n.routes }
+func (n *testNotifier) Notify(alert *Alert) { n.alerts = append(n.alerts, alert) }
+
+func TestAlerterNotify(t *testing.T) {
+	tests := []struct {
+		name    string
+		routes  []string
+		matched []string
+	}{
+		{name: "all categories", matched: []string{"Site/Servers", "Site", "Web"}},
+		{name: "nested and ancestor", routes: []string{"Site", "Site/Servers"}, matched: []string{"Site/Servers", "Site"}},
+		{name: "one route", routes: []string{"Web", "Other"}, matched: []string{"Web"}},
+		{name: "no match", routes: []string{"Other"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			n := &testNotifier{routes: test.routes}
+			a := &Alerter{notifiers: []Notifier{n}}
+			a.Notify([]*Transition{
+				{Hostname: "example.com", Categories: []string{"Site/Servers", "Site", "Web"}, Status: StatusDown, Previous: StatusUp},
+				{Hostname: "suppressed.example.com", Categories: []string{"Site/Servers", "Site", "Web"}, Status: StatusDown, Previous: StatusUp, Suppressed: true},
+			})
+
+			if test.matched == nil {
+				if len(n.alerts) != 0 {
+					t.Errorf("sent %d alerts, want 0", len(n.alerts))
+				}
+				return
+			}
+			if len(n.alerts) != 1 {
+				t.Fatalf("sent %d alerts, want 1", len(n.alerts))
+			}
+			if !reflect.DeepEqual(n.alerts[0].Matched, test.matched) {
+				t.Errorf("matched = %q, want %q", n.alerts[0].Matched, test.matched)
+			}
+			if n.alerts[0].Category != test.matched[0] {
+				t.Errorf("category = %q, want %q", n.alerts[0].Category, test.matched[0])
+			}
+		})
+	}
+}
@@ -0,0 +1,530 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// writeJSON writes v to w as JSON with the given status code
+func writeJSON(w http.ResponseWriter, r *http.Request, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		l := r.Context().Value(ContextKeyLog).(*Log)
+		l.Error = &Error{fmt.Errorf("could not encode response: %w", err)}
+	}
+}
+
+// writeError logs err and writes it to w as JSON with the given status code
+func writeError(w http.ResponseWriter, r *http.Request, status int, err error) {
+	l := r.Context().Value(ContextKeyLog).(*Log)
+	l.Error = &Error{err}
+	writeJSON(w, r, status, map[string]string{"error": err.Error()})
+}
+
+// parseTime parses an RFC 3339 time or returns def if s is empty
+func parseTime(s string, def time.Time) (time.Time, error) {
+	if s == "" {
+		return def, nil
+	}
+	return time.Parse(time.RFC3339, s)
+}
+
+// History query limits
+const (
+	defaultHistoryLimit = 10000
+	maxHistoryLimit     = 100000
+)
+
+// HandleHistory returns an http.Handler that returns the recorded history of the host query parameter between the from and to
+// query parameters (RFC 3339 times, defaulting to the last 24 hours). At most the limit query parameter samples are returned
+func (s *Service) HandleHistory() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			writeError(w, r, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+			return
+		}
+
+		if s.History == nil {
+			writeError(w, r, http.StatusNotFound, errors.New("history is not enabled"))
+			return
+		}
+
+		q := r.URL.Query()
+		host := q.Get("host")
+		if host == "" {
+			writeError(w, r, http.StatusBadRequest, errors.New("host is required"))
+			return
+		}
+
+		now := time.Now()
+		to, err := parseTime(q.Get("to"), now)
+		if err != nil {
+			writeError(w, r, http.StatusBadRequest, fmt.Errorf("could not parse to: %w", err))
+			return
+		}
+		from, err := parseTime(q.Get("from"), to.Add(-24*time.Hour))
+		if err != nil {
+			writeError(w, r, http.StatusBadRequest, fmt.Errorf("could not parse from: %w", err))
+			return
+		}
+		if from.After(to) {
+			writeError(w, r, http.StatusBadRequest, errors.New("from must be before to"))
+			return
+		}
+
+		limit := defaultHistoryLimit
+		if l := q.Get("limit"); l != "" {
+			if limit, err = strconv.Atoi(l); err != nil || limit < 1 || limit > maxHistoryLimit {
+				writeError(w, r, http.StatusBadRequest, fmt.Errorf("limit must be between 1 and %d", maxHistoryLimit))
+				return
+			}
+		}
+
+		series, truncated, err := s.History.Query(host, from, to, limit)
+		if err != nil {
+			writeError(w, r, http.StatusInternalServerError, fmt.Errorf("could not query history: %w", err))
+			return
+		}
+
+		writeJSON(w, r, http.StatusOK, map[string]interface{}{
+			"host":      host,
+			"from":      from,
+			"to":        to,
+			"series":    series,
+			"truncated": truncated,
+		})
+	})
+}
+
+type apiHostState struct {
+	Host     string      `json:"host"`
+	Status   Status      `json:"status"`
+	Since    time.Time   `json:"since"`
+	Observed Status      `json:"observed"`
+	Flapping bool        `json:"flapping"`
+	Changes  []time.Time `json:"changes"`
+
+	Cause       string `json:"cause,omitempty"`
+	Maintenance bool   `json:"maintenance"`
+}
+
+func newAPIHostState(hs *HostState) *apiHostState {
+	return &apiHostState{
+		Host:     hs.Hostname,
+		Status:   hs.Status,
+		Since:    hs.Since,
+		Observed: hs.Observed,
+		Flapping: hs.Flapping,
+		Changes:  hs.Changes,
+
+		Cause:       hs.Cause,
+		Maintenance: hs.Maintenance,
+	}
+}
+
+// HandleStates returns an http.Handler that returns the state of every host, or only the host query parameter if given
+func (s *Service) HandleStates() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			writeError(w, r, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+			return
+		}
+
+		host := r.URL.Query().Get("host")
+		states := make([]*apiHostState, 0)
+		for _, hs := range s.State.HostStates() {
+			if host != "" && hs.Hostname != host {
+				continue
+			}
+			states = append(states, newAPIHostState(hs))
+		}
+
+		if host != "" && len(states) == 0 {
+			writeError(w, r, http.StatusNotFound, fmt.Errorf("host %q not found", host))
+			return
+		}
+
+		writeJSON(w, r, http.StatusOK, states)
+	})
+}
+
+// HandleMaintenance returns an http.Handler that lists maintenance windows (GET), adds a window from the JSON body (POST),
+// or removes the window with the id query parameter (DELETE)
+func (s *Service) HandleMaintenance() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, r, http.StatusOK, s.Maintenance.Windows())
+		case http.MethodPost:
+			window := new(MaintenanceWindow)
+			if err := json.NewDecoder(r.Body).Decode(window); err != nil {
+				writeError(w, r, http.StatusBadRequest, fmt.Errorf("could not decode window: %w", err))
+				return
+			}
+			if err := window.Validate(); err != nil {
+				writeError(w, r, http.StatusBadRequest, fmt.Errorf("invalid window: %w", err))
+				return
+			}
+			if err := s.Maintenance.Add(window); err != nil {
+				writeError(w, r, http.StatusInternalServerError, fmt.Errorf("could not add window: %w", err))
+				return
+			}
+			s.State.SetMaintenance(s.stateConfig())
+			writeJSON(w, r, http.StatusCreated, window)
+		case http.MethodDelete:
+			err := s.Maintenance.Remove(r.URL.Query().Get("id"))
+			if errors.Is(err, ErrWindowNotFound) {
+				writeError(w, r, http.StatusNotFound, err)
+				return
+			} else if err != nil {
+				writeError(w, r, http.StatusInternalServerError, fmt.Errorf("could not remove window: %w", err))
+				return
+			}
+			s.State.SetMaintenance(s.stateConfig())
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			writeError(w, r, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+		}
+	})
+}
+
+// hostState returns the state of host, or nil if it isn't being monitored
+func (s *Service) hostState(host string) *HostState {
+	for _, hs := range s.State.HostStates() {
+		if hs.Hostname == host {
+			return hs
+		}
+	}
+	return nil
+}
+
+// apiUser returns the user r is authenticated as. Requests that change state always use basic authentication,
+// so the user can't be set by the client
+func apiUser(r *http.Request) string {
+	u, _, _ := r.BasicAuth()
+	return u
+}
+
+// HandleAcks returns an http.Handler that lists acks and silences (GET), acknowledges the down host in the JSON body (POST),
+// or removes the ack for the host query parameter (DELETE)
+func (s *Service) HandleAcks() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, r, http.StatusOK, s.Acks.List())
+		case http.MethodPost:
+			ack := new(Ack)
+			if err := json.NewDecoder(r.Body).Decode(ack); err != nil {
+				writeError(w, r, http.StatusBadRequest, fmt.Errorf("could not decode ack: %w", err))
+				return
+			}
+			hs := s.hostState(ack.Host)
+			if hs == nil {
+				writeError(w, r, http.StatusNotFound, fmt.Errorf("host %q not found", ack.Host))
+				return
+			}
+			if hs.Status == StatusUp || hs.Status == StatusUnknown {
+				writeError(w, r, http.StatusConflict, fmt.Errorf("host %q is %s", ack.Host, hs.Status))
+				return
+			}
+			ack.User = apiUser(r)
+			ack.Time = time.Now()
+			if err := s.Acks.Ack(ack); err != nil {
+				writeError(w, r, http.StatusInternalServerError, fmt.Errorf("could not add ack: %w", err))
+				return
+			}
+			s.State.SetAcks(s.Acks.Message())
+			writeJSON(w, r, http.StatusCreated, ack)
+		case http.MethodDelete:
+			err := s.Acks.Unack(r.URL.Query().Get("host"))
+			if errors.Is(err, ErrAckNotFound) {
+				writeError(w, r, http.StatusNotFound, err)
+				return
+			} else if err != nil {
+				writeError(w, r, http.StatusInternalServerError, fmt.Errorf("could not remove ack: %w", err))
+				return
+			}
+			s.State.SetAcks(s.Acks.Message())
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			writeError(w, r, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+		}
+	})
+}
+
+// HandleSilences returns an http.Handler that lists silences (GET), silences the host or category in the JSON body
+// for its duration (POST), or removes the silence with the id query parameter (DELETE)
+func (s *Service) HandleSilences() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, r, http.StatusOK, s.Acks.List().Silences)
+		case http.MethodPost:
+			type request struct {
+				Host     string `json:"host"`
+				Category string `json:"category"`
+				Duration string `json:"duration"`
+				Comment  string `json:"comment"`
+			}
+			req := new(request)
+			if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+				writeError(w, r, http.StatusBadRequest, fmt.Errorf("could not decode silence: %w", err))
+				return
+			}
+			if (req.Host == "") == (req.Category == "") {
+				writeError(w, r, http.StatusBadRequest, errors.New("one of host or category must be set"))
+				return
+			}
+			duration, err := time.ParseDuration(req.Duration)
+			if err != nil || duration <= 0 {
+				writeError(w, r, http.StatusBadRequest, fmt.Errorf("invalid duration %q", req.Duration))
+				return
+			}
+
+			now := time.Now()
+			silence := &Silence{
+				Host:     req.Host,
+				Category: req.Category,
+				User:     apiUser(r),
+				Comment:  req.Comment,
+				Time:     now,
+				Expires:  now.Add(duration),
+			}
+			if err = s.Acks.Silence(silence); err != nil {
+				writeError(w, r, http.StatusInternalServerError, fmt.Errorf("could not add silence: %w", err))
+				return
+			}
+			s.State.SetAcks(s.Acks.Message())
+			writeJSON(w, r, http.StatusCreated, silence)
+		case http.MethodDelete:
+			err := s.Acks.Unsilence(r.URL.Query().Get("id"))
+			if errors.Is(err, ErrSilenceNotFound) {
+				writeError(w, r, http.StatusNotFound, err)
+				return
+			} else if err != nil {
+				writeError(w, r, http.StatusInternalServerError, fmt.Errorf("could not remove silence: %w", err))
+				return
+			}
+			s.State.SetAcks(s.Acks.Message())
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			writeError(w, r, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+		}
+	})
+}
+
+// apiFilter filters hosts by the category, tag and state query parameters. Each can be given more than once or as a comma separated list,
+// and a host matches a parameter if it matches any of its values
+type apiFilter struct {
+	categories []string
+	tags       []string
+	states     []string
+}
+
+// newAPIFilter parses the filter query parameters of r, and returns an error if a state is unknown
+func newAPIFilter(r *http.Request) (*apiFilter, error) {
+	q := r.URL.Query()
+	values := func(key string) []string {
+		vals := make([]string, 0)
+		for _, v := range q[key] {
+			vals = append(vals, splitList(v)...)
+		}
+		return vals
+	}
+
+	f := &apiFilter{categories: values("category"), tags: values("tag"), states: values("state")}
+	for _, st := range f.states {
+		if _, ok := statusRank[Status(st)]; !ok {
+			return nil, fmt.Errorf("unknown state %q", st)
+		}
+	}
+	return f, nil
+}
+
+// matchAny returns true if filter is empty or any of values are in filter
+func matchAny(filter, values []string) bool {
+	if len(filter) == 0 {
+		return true
+	}
+	for _, v := range values {
+		if containsString(filter, v) {
+			return true
+		}
+	}
+	return false
+}
+
+// match returns true if status matches f. Hosts match the categories they're in and their ancestors
+func (f *apiFilter) match(status *HostStatus) bool {
+	return matchAny(f.categories, status.Categories) && matchAny(f.tags, status.Tags) && matchAny(f.states, []string{string(status.State)})
+}
+
+// hostStatuses returns the status of every host matching f, or an error if a category in f doesn't exist
+func (f *apiFilter) hostStatuses(state *State) ([]*HostStatus, error) {
+	if len(f.categories) > 0 {
+		paths := make([]string, 0)
+		for _, c := range state.Schema().Categories() {
+			paths = append(paths, c.Path)
+		}
+		for _, c := range f.categories {
+			if !containsString(paths, c) {
+				return nil, fmt.Errorf("category %q not found", c)
+			}
+		}
+	}
+
+	statuses := make([]*HostStatus, 0)
+	for _, status := range state.HostStatuses() {
+		if f.match(status) {
+			statuses = append(statuses, status)
+		}
+	}
+	return statuses, nil
+}
+
+// HandleHosts returns an http.Handler that returns the state, resolved IPs and latest probe results of every host matching the filter
+// query parameters, in the order they're in the hosts file, or of the host in the path (/api/v1/hosts/<host>)
+func (s *Service) HandleHosts() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			writeError(w, r, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+			return
+		}
+
+		if host := strings.TrimPrefix(strings.TrimPrefix(r.URL.Path, "/api/v1/hosts"), "/"); host != "" {
+			for _, status := range s.State.HostStatuses() {
+				if status.Host == host {
+					writeJSON(w, r, http.StatusOK, status)
+					return
+				}
+			}
+			writeError(w, r, http.StatusNotFound, fmt.Errorf("host %q not found", host))
+			return
+		}
+
+		f, err := newAPIFilter(r)
+		if err != nil {
+			writeError(w, r, http.StatusBadRequest, err)
+			return
+		}
+		statuses, err := f.hostStatuses(s.State)
+		if err != nil {
+			writeError(w, r, http.StatusNotFound, err)
+			return
+		}
+
+		writeJSON(w, r, http.StatusOK, statuses)
+	})
+}
+
+type apiIP struct {
+	Host string `json:"host"`
+	*IPStatus
+}
+
+// HandleIPs returns an http.Handler that returns the latest probe results of every resolved IP of the hosts matching the filter query parameters
+func (s *Service) HandleIPs() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			writeError(w, r, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+			return
+		}
+
+		f, err := newAPIFilter(r)
+		if err != nil {
+			writeError(w, r, http.StatusBadRequest, err)
+			return
+		}
+		statuses, err := f.hostStatuses(s.State)
+		if err != nil {
+			writeError(w, r, http.StatusNotFound, err)
+			return
+		}
+
+		ips := make([]*apiIP, 0)
+		for _, status := range statuses {
+			for _, ip := range status.IPs {
+				ips = append(ips, &apiIP{Host: status.Host, IPStatus: ip})
+			}
+		}
+
+		writeJSON(w, r, http.StatusOK, ips)
+	})
+}
+
+type apiCategoryCounts struct {
+	Status      Status `json:"status"`
+	Up          int    `json:"up"`
+	Degraded    int    `json:"degraded"`
+	Down        int    `json:"down"`
+	Unreachable int    `json:"unreachable"`
+	Unknown     int    `json:"unknown"`
+	Maintenance int    `json:"maintenance"`
+}
+
+type apiCategory struct {
+	Category   string             `json:"category"`
+	Path       string             `json:"path"`
+	Hosts      []string           `json:"hosts"`
+	Counts     *apiCategoryCounts `json:"counts"`
+	Categories []*apiCategory     `json:"categories"`
+}
+
+func newAPICategory(c *Category, aggregates Aggregates) *apiCategory {
+	ac := &apiCategory{Category: c.Category, Path: c.Path, Hosts: make([]string, 0, len(c.Hosts)), Categories: make([]*apiCategory, 0, len(c.Categories))}
+	for _, h := range c.Hosts {
+		ac.Hosts = append(ac.Hosts, h.Host)
+	}
+	if counts, ok := aggregates[c.Path]; ok {
+		ac.Counts = &apiCategoryCounts{
+			Status:      counts.Status,
+			Up:          counts.Up,
+			Degraded:    counts.Degraded,
+			Down:        counts.Down,
+			Unreachable: counts.Unreachable,
+			Unknown:     counts.Unknown,
+			Maintenance: counts.Maintenance,
+		}
+	}
+	for _, nested := range c.Categories {
+		ac.Categories = append(ac.Categories, newAPICategory(nested, aggregates))
+	}
+	return ac
+}
+
+// HandleCategories returns an http.Handler that returns the category tree with the hosts directly in each category and the
+// counts of hosts in each state, including nested categories. If the category query parameter is given, only that category is returned
+func (s *Service) HandleCategories() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			writeError(w, r, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+			return
+		}
+
+		schema, aggregates := s.State.Schema(), s.State.Aggregates()
+
+		if path := r.URL.Query().Get("category"); path != "" {
+			for _, c := range schema.Categories() {
+				if c.Path == path {
+					writeJSON(w, r, http.StatusOK, []*apiCategory{newAPICategory(c, aggregates)})
+					return
+				}
+			}
+			writeError(w, r, http.StatusNotFound, fmt.Errorf("category %q not found", path))
+			return
+		}
+
+		categories := make([]*apiCategory, 0, len(schema))
+		for _, c := range schema {
+			categories = append(categories, newAPICategory(c, aggregates))
+		}
+
+		writeJSON(w, r, http.StatusOK, categories)
+	})
+}
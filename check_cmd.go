@@ -0,0 +1,210 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Nagios plugin exit codes
+const (
+	checkOK       = 0
+	checkWarning  = 1
+	checkCritical = 2
+	checkUnknown  = 3
+)
+
+var checkLabels = map[int]string{checkOK: "OK", checkWarning: "WARNING", checkCritical: "CRITICAL", checkUnknown: "UNKNOWN"}
+
+// checkRank orders check states from best to worst. Unknown hosts don't hide warnings or critical hosts
+var checkRank = map[int]int{checkOK: 0, checkUnknown: 1, checkWarning: 2, checkCritical: 3}
+
+// checkThreshold is a round trip average and packet loss percentage, like check_ping's -w and -c options
+type checkThreshold struct {
+	RTA  time.Duration
+	Loss float64
+}
+
+// parseThreshold parses a threshold in the form rta,loss%, where rta is in milliseconds
+func parseThreshold(str string) (*checkThreshold, error) {
+	parts := strings.Split(str, ",")
+	if len(parts) != 2 || !strings.HasSuffix(parts[1], "%") {
+		return nil, fmt.Errorf("invalid threshold %q: must be rta,loss%%", str)
+	}
+	rta, err := strconv.ParseFloat(parts[0], 64)
+	if err != nil || rta < 0 {
+		return nil, fmt.Errorf("invalid threshold %q: invalid rta %q", str, parts[0])
+	}
+	loss, err := strconv.ParseFloat(strings.TrimSuffix(parts[1], "%"), 64)
+	if err != nil || loss < 0 || loss > 100 {
+		return nil, fmt.Errorf("invalid threshold %q: invalid loss %q", str, parts[1])
+	}
+	return &checkThreshold{RTA: time.Duration(rta * float64(time.Millisecond)), Loss: loss}, nil
+}
+
+// exceeded returns true if latency or loss are at or over the threshold
+func (t *checkThreshold) exceeded(latency time.Duration, loss float64) bool {
+	return latency >= t.RTA || loss >= t.Loss
+}
+
+// checkHost returns the check state of status and, if it isn't OK, why.
+// Down and unreachable hosts are critical and degraded hosts are at least a warning
+func checkHost(status *HostStatus, warning, critical *checkThreshold) (int, string) {
+	sent, received, latency := status.PingSummary()
+	loss := 0.0
+	if sent > 0 {
+		loss = float64(sent-received) / float64(sent) * 100
+	}
+
+	reason := fmt.Sprintf("%s: %s", status.Host, status.State)
+	if sent > 0 {
+		reason = fmt.Sprintf("%s: %s, rta %.3fms, lost %.0f%%", status.Host, status.State, float64(latency)/float64(time.Millisecond), loss)
+	}
+
+	switch {
+	case isDown(status.State) || (sent > 0 && critical.exceeded(latency, loss)):
+		return checkCritical, reason
+	case status.State == StatusDegraded || (sent > 0 && warning.exceeded(latency, loss)):
+		return checkWarning, reason
+	case status.State == StatusUnknown:
+		return checkUnknown, reason
+	}
+	return checkOK, ""
+}
+
+// checkResult returns the Nagios plugin status line for statuses, the hosts in name, and the exit code
+func checkResult(name string, statuses []*HostStatus, warning, critical *checkThreshold) (string, int) {
+	code := checkOK
+	var sent, received, responded int
+	var latency time.Duration
+	reasons := make([]string, 0)
+	for _, status := range statuses {
+		c, reason := checkHost(status, warning, critical)
+		if checkRank[c] > checkRank[code] {
+			code = c
+		}
+		if reason != "" {
+			reasons = append(reasons, reason)
+		}
+
+		s, r, l := status.PingSummary()
+		sent, received = sent+s, received+r
+		if r > 0 {
+			latency += l
+			responded++
+		}
+	}
+
+	rta, pl := "U", "U"
+	summary := fmt.Sprintf("%d hosts", len(statuses))
+	if len(statuses) == 1 {
+		summary = "1 host"
+	}
+	if responded > 0 {
+		latency /= time.Duration(responded)
+		rta = fmt.Sprintf("%.3fms", float64(latency)/float64(time.Millisecond))
+	}
+	if sent > 0 {
+		pl = fmt.Sprintf("%.0f%%", float64(sent-received)/float64(sent)*100)
+		if responded > 0 {
+			summary += ", rta " + rta
+		}
+		summary += ", lost " + pl
+	}
+	if len(reasons) > 0 {
+		summary = fmt.Sprintf("%s (%s)", summary, strings.Join(reasons, "; "))
+	}
+
+	perfdata := fmt.Sprintf("rta=%s;%.3f;%.3f;0; pl=%s;%.0f;%.0f;0;100",
+		rta, float64(warning.RTA)/float64(time.Millisecond), float64(critical.RTA)/float64(time.Millisecond),
+		pl, warning.Loss, critical.Loss,
+	)
+
+	return fmt.Sprintf("PING %s - %s: %s|%s", checkLabels[code], name, summary, perfdata), code
+}
+
+// RunCheck scans a category or host from the hosts file once and prints a Nagios plugin status line with perfdata.
+// It returns the Nagios plugin exit code
+func RunCheck(args []string) int {
+	config, err := LoadConfig()
+	if err != nil {
+		fmt.Println("PING UNKNOWN -", err)
+		return checkUnknown
+	}
+
+	flags := flag.NewFlagSet("check", flag.ContinueOnError)
+	category := flags.String("category", "", "category path to check, including its nested categories")
+	host := flags.String("host", "", "host to check")
+	warningFlag := flags.String("w", "200,20%", "warning threshold as rta,loss%, where rta is in milliseconds")
+	criticalFlag := flags.String("c", "500,60%", "critical threshold as rta,loss%, where rta is in milliseconds")
+	maxExpand := flags.Int("max-expand", config.MaxExpand, "maximum amount of addresses a host can expand to")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: %s check -category <path> | -host <host> [flags] [hosts file]\n\n", os.Args[0])
+		fmt.Fprintln(flags.Output(), "Scans a category or host in the hosts file (default HOSTSPATH) once and prints a Nagios plugin status line. Flags:")
+		flags.PrintDefaults()
+	}
+	if err = flags.Parse(args); err != nil {
+		return checkUnknown
+	}
+
+	unknown := func(format string, a ...interface{}) int {
+		fmt.Printf("PING UNKNOWN - "+format+"\n", a...)
+		return checkUnknown
+	}
+
+	if (*category == "") == (*host == "") {
+		return unknown("exactly one of -category or -host must be given")
+	}
+
+	warning, err := parseThreshold(*warningFlag)
+	if err != nil {
+		return unknown("%v", err)
+	}
+	critical, err := parseThreshold(*criticalFlag)
+	if err != nil {
+		return unknown("%v", err)
+	}
+
+	path := config.HostsPath
+	if flags.NArg() > 1 {
+		return unknown("too many arguments")
+	} else if flags.NArg() == 1 {
+		path = flags.Arg(0)
+	}
+	if path == "" {
+		return unknown("no hosts file given and HOSTSPATH isn't set")
+	}
+
+	schema, _, err := LoadSchema(path, *maxExpand)
+	if err != nil {
+		return unknown("%v", err)
+	}
+
+	name := *category
+	if *host != "" {
+		name = *host
+		schema = filterSchema(schema, nil, []string{*host})
+	} else {
+		schema = filterSchema(schema, []string{*category}, nil)
+	}
+	if len(schema) == 0 {
+		return unknown("%s not found in hosts file", name)
+	}
+
+	svc, err := newCommandService(config)
+	if err != nil {
+		return unknown("%v", err)
+	}
+
+	statuses, err := svc.ScanOnce(schema)
+	if err != nil {
+		return unknown("%v", err)
+	}
+
+	line, code := checkResult(name, statuses, warning, critical)
+	fmt.Println(line)
+	return code
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// Websocket commands
+const (
+	// CommandProbe probes a host or category immediately, without waiting for the next scan
+	CommandProbe = "probe"
+	// CommandPause stops sending updates to the client
+	CommandPause = "pause"
+	// CommandResume sends the current state and resumes sending updates to the client
+	CommandResume = "resume"
+)
+
+// Command is a command sent by a client over the websocket
+type Command struct {
+	// ID is sent back in the response so clients can match them to their commands
+	ID      string `json:"i"`
+	Command string `json:"c"`
+	// Host or Category (a category path) is the target of CommandProbe
+	Host     string `json:"h"`
+	Category string `json:"g"`
+	// err is set if the command couldn't be parsed
+	err error
+}
+
+// CommandResponse is the response to a Command
+type CommandResponse struct {
+	ID      string
+	Command string
+	// Hosts is the amount of hosts probed by CommandProbe
+	Hosts int
+	Error error
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (r *CommandResponse) MarshalJSON() ([]byte, error) {
+	type response struct {
+		Type    string `json:"t"`
+		ID      string `json:"i"`
+		Command string `json:"c"`
+		Hosts   int    `json:"n,omitempty"`
+		Error   string `json:"e,omitempty"`
+	}
+
+	res := &response{Type: "k", ID: r.ID, Command: r.Command, Hosts: r.Hosts}
+	if r.Error != nil {
+		res.Error = r.Error.Error()
+	}
+
+	return json.Marshal(res)
+}
+
+// probeSchema returns the schema with only the host or category cmd targets, and the amount of hosts in it
+func (s *Service) probeSchema(cmd *Command) (Schema, int, error) {
+	var schema Schema
+	switch {
+	case cmd.Host != "" && cmd.Category != "":
+		return nil, 0, errors.New("only one of host or category can be given")
+	case cmd.Host != "":
+		schema = filterSchema(s.State.Schema(), nil, []string{cmd.Host})
+		if len(schema) == 0 {
+			return nil, 0, fmt.Errorf("host %q not found", cmd.Host)
+		}
+	case cmd.Category != "":
+		schema = filterSchema(s.State.Schema(), []string{cmd.Category}, nil)
+		if len(schema) == 0 {
+			return nil, 0, fmt.Errorf("category %q not found", cmd.Category)
+		}
+	default:
+		return nil, 0, errors.New("host or category is required")
+	}
+
+	hosts := make(map[string]struct{})
+	for _, c := range schema.Categories() {
+		for _, h := range c.Hosts {
+			hosts[h.Host] = struct{}{}
+		}
+	}
+	return schema, len(hosts), nil
+}
+
+// startProbe returns true if a probe can be started, or false if Config.MaxProbes are already running. endProbe must be called when it's finished
+func (s *Service) startProbe() bool {
+	select {
+	case s.probes <- struct{}{}:
+		return true
+	default:
+		return false
+	}
+}
+
+func (s *Service) endProbe() {
+	<-s.probes
+}
+
+// probe scans schema and stores the results like a scheduled scan, so they're sent to every client.
+// The probed hosts' states are then updated with the usual thresholds, so a probe counts as one more observation
+func (s *Service) probe(schema Schema) error {
+	if err := s.Scan(schema, s.record); err != nil {
+		return fmt.Errorf("could not probe hosts: %w", err)
+	}
+
+	config := s.stateConfig()
+	config.Hosts = make(map[string]struct{})
+	for _, c := range schema.Categories() {
+		for _, h := range c.Hosts {
+			config.Hosts[h.Host] = struct{}{}
+		}
+	}
+	s.updateHostStates(config)
+
+	return nil
+}
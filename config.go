@@ -1,20 +1,86 @@
 package main
 
-import "time"
+import (
+	"fmt"
+	"runtime"
+	"time"
 
-// Config configures ping-dashboard
+	"github.com/kelseyhightower/envconfig"
+)
+
+// Config configures ping-dashboard. HostsPath and Password are required by the server
 type Config struct {
-	HostsPath string        `required:"true"`
+	HostsPath string        `default:""`
 	Pingers   int           `default:"0"`
 	Resolvers int           `default:"0"`
 	QueueSize int           `default:"1024"`
 	Timeout   time.Duration `default:"1s"`
+	Interval  time.Duration `default:"1m"`
+	// MaxProbes is the maximum amount of dashboard re-probes that can run at once across all clients
+	MaxProbes int `default:"2"`
+
+	WatchInterval time.Duration `default:"5s"`
+	MaxExpand     int           `default:"1024"`
+
+	Echoes          int           `default:"3"`
+	EchoInterval    time.Duration `default:"100ms"`
+	DegradedLoss    float64       `default:"10"` // percent
+	DegradedLatency time.Duration `default:"250ms"`
+
+	TLSWarnDays int `default:"30"`
+	TLSCritDays int `default:"7"`
+
+	FailThreshold    int           `default:"2"`
+	RecoverThreshold int           `default:"2"`
+	FlapWindow       time.Duration `default:"30m"`
+	FlapThreshold    int           `default:"5"`
+
+	AlertsPath      string `default:""`
+	MaintenancePath string `default:""`
+	AcksPath        string `default:""`
+
+	HistoryPath         string        `default:""`
+	HistoryRetention    time.Duration `default:"720h"` // 30 days
+	HistoryRawRetention time.Duration `default:"48h"`
+	HistoryResolution   time.Duration `default:"5m"`
 
 	Username        string        `default:"admin"`
-	Password        string        `required:"true"`
+	Password        string        `default:""`
 	AuthRateLimit   int           `default:"3"` // 3 requests per minute
 	SessionDuration time.Duration `default:"30m"`
 
+	// MetricsAuth is how /metrics is authenticated: basic (USERNAME and PASSWORD), token (METRICSTOKEN as a bearer token) or none
+	MetricsAuth  string `default:"basic"`
+	MetricsToken string `default:""`
+	// ProbeAuth is how /probe is authenticated, like MetricsAuth. It's set separately since probes can be sent to any target
+	ProbeAuth  string `default:"basic"`
+	ProbeToken string `default:""`
+	// ModulesPath is the path to the /probe modules configuration
+	ModulesPath string `default:""`
+
 	ProxyHeaders bool   `default:"false"`
 	ListenAddr   string `default:":80"`
 }
+
+// LoadConfig returns the configuration from the environment with defaults applied
+func LoadConfig() (*Config, error) {
+	config := new(Config)
+	if err := envconfig.Process("", config); err != nil {
+		return nil, fmt.Errorf("could not process configuration from environment: %w", err)
+	}
+
+	if config.Resolvers == 0 {
+		config.Resolvers = runtime.NumCPU() * 4
+	}
+	if config.Pingers == 0 {
+		config.Pingers = runtime.NumCPU() * 2
+	}
+	if config.Echoes < 1 {
+		config.Echoes = 1
+	}
+	if config.MaxProbes < 1 {
+		config.MaxProbes = 1
+	}
+
+	return config, nil
+}
@@ -0,0 +1,270 @@
+package main
+
+import (
+	"bytes"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/smtp"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// defaultSMTPTimeout is the default timeout for connecting to an SMTP server
+const defaultSMTPTimeout = 10 * time.Second
+
+// Email sends alerts with SMTP. If Categories is empty, alerts for all categories are sent.
+// If Digest is non-zero, alerts are batched: the first alert starts a Digest long window, and all alerts in the window are sent in one message.
+// If StartTLS is true, the connection must be upgraded with STARTTLS before authenticating or sending.
+// Authentication uses PLAIN, which net/smtp refuses to send without TLS unless Host is localhost
+type Email struct {
+	EmailName  string        `yaml:"name"`
+	Host       string        `yaml:"host"`
+	Port       int           `yaml:"port"`
+	StartTLS   bool          `yaml:"starttls"`
+	Username   string        `yaml:"username"`
+	Password   string        `yaml:"password"`
+	From       string        `yaml:"from"`
+	To         []string      `yaml:"to"`
+	Categories []string      `yaml:"categories"`
+	Digest     time.Duration `yaml:"digest"`
+	Timeout    time.Duration `yaml:"timeout"`
+
+	pending []*Alert
+	mu      sync.Mutex
+}
+
+// Validate returns an error if the notifier is invalid, and applies defaults
+func (e *Email) Validate() error {
+	if e.Host == "" {
+		return errors.New("host must not be empty")
+	}
+	if e.Port == 0 {
+		e.Port = 25
+	}
+	if err := validatePorts([]int{e.Port}); err != nil {
+		return err
+	}
+	if e.From == "" {
+		return errors.New("from must not be empty")
+	}
+	if len(e.To) == 0 {
+		return errors.New("to must not be empty")
+	}
+	for _, addr := range append([]string{e.From}, e.To...) {
+		if strings.ContainsAny(addr, "\r\n") {
+			return fmt.Errorf("invalid address %q", addr)
+		}
+	}
+	if e.Username != "" && !e.StartTLS && !isLocalhost(e.Host) {
+		return errors.New("starttls must be enabled to authenticate to a host other than localhost")
+	}
+	if e.Digest < 0 {
+		return errors.New("digest must not be negative")
+	}
+	if e.Timeout == 0 {
+		e.Timeout = defaultSMTPTimeout
+	}
+	return nil
+}
+
+// isLocalhost returns true if host is one that net/smtp will send PLAIN credentials to without TLS
+func isLocalhost(host string) bool {
+	return host == "localhost" || host == "127.0.0.1" || host == "::1"
+}
+
+// Name returns the name of the notifier, or its recipients if it doesn't have one
+func (e *Email) Name() string {
+	if e.EmailName != "" {
+		return e.EmailName
+	}
+	return strings.Join(e.To, ",")
+}
+
+// Routes implements the Notifier interface
+func (e *Email) Routes() []string {
+	return e.Categories
+}
+
+// Notify implements the Notifier interface
+func (e *Email) Notify(alert *Alert) {
+	if e.Digest == 0 {
+		go e.send([]*Alert{alert})
+		return
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.pending = append(e.pending, alert)
+	if len(e.pending) == 1 {
+		time.AfterFunc(e.Digest, e.flush)
+	}
+}
+
+// flush sends all pending alerts
+func (e *Email) flush() {
+	e.mu.Lock()
+	alerts := e.pending
+	e.pending = nil
+	e.mu.Unlock()
+
+	if len(alerts) > 0 {
+		e.send(alerts)
+	}
+}
+
+func (e *Email) send(alerts []*Alert) {
+	if err := e.Send(alerts); err != nil {
+		log.Printf("could not send %d alert(s) to email %s: %v", len(alerts), e.Name(), err)
+	}
+}
+
+// alertName returns the name of the host in an alert
+func alertName(a *Alert) string {
+	if a.Name != "" {
+		return fmt.Sprintf("%s (%s)", a.Name, a.Host)
+	}
+	return a.Host
+}
+
+// alertVerb describes the state of a host in an alert
+func alertVerb(a *Alert) string {
+	if a.State == StatusUp {
+		return "recovered"
+	}
+	return "is " + string(a.State)
+}
+
+// Message returns the RFC 5322 message for alerts, sorted by time
+func (e *Email) Message(alerts []*Alert) []byte {
+	digested := make([]*Alert, len(alerts))
+	copy(digested, alerts)
+	sort.SliceStable(digested, func(i, j int) bool { return digested[i].Time.Before(digested[j].Time) })
+
+	var subject string
+	if len(digested) == 1 {
+		subject = fmt.Sprintf("%s %s", alertName(digested[0]), alertVerb(digested[0]))
+	} else {
+		counts := make(map[string]int)
+		for _, a := range digested {
+			counts[alertVerb(a)]++
+		}
+		verbs := make([]string, 0, len(counts))
+		for verb := range counts {
+			verbs = append(verbs, verb)
+		}
+		sort.Strings(verbs)
+		parts := make([]string, 0, len(verbs))
+		for _, verb := range verbs {
+			parts = append(parts, fmt.Sprintf("%d %s", counts[verb], strings.TrimPrefix(verb, "is ")))
+		}
+		subject = fmt.Sprintf("%d hosts changed state: %s", len(digested), strings.Join(parts, ", "))
+	}
+
+	hostname, _ := os.Hostname()
+	if hostname == "" {
+		hostname = "localhost"
+	}
+
+	buf := new(bytes.Buffer)
+	fmt.Fprintf(buf, "From: %s\r\n", e.From)
+	fmt.Fprintf(buf, "To: %s\r\n", strings.Join(e.To, ", "))
+	fmt.Fprintf(buf, "Subject: [ping-dashboard] %s\r\n", subject)
+	fmt.Fprintf(buf, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	fmt.Fprintf(buf, "Message-ID: <%d.%d@%s>\r\n", time.Now().UnixNano(), os.Getpid(), hostname)
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	buf.WriteString("\r\n")
+
+	for _, a := range digested {
+		fmt.Fprintf(buf, "%s %s (was %s for %s)\r\n", alertName(a), alertVerb(a), a.Previous, a.Duration.Round(time.Second))
+		fmt.Fprintf(buf, "  Time: %s\r\n", a.Time.Format(time.RFC1123))
+		if len(a.Matched) == 1 {
+			fmt.Fprintf(buf, "  Category: %s\r\n", a.Matched[0])
+		} else if len(a.Matched) > 1 {
+			fmt.Fprintf(buf, "  Categories: %s\r\n", strings.Join(a.Matched, ", "))
+		}
+		if len(a.IPs) > 0 {
+			fmt.Fprintf(buf, "  IPs: %s\r\n", strings.Join(a.IPs, ", "))
+		}
+		if a.Description != "" {
+			fmt.Fprintf(buf, "  Description: %s\r\n", a.Description)
+		}
+		if a.Owner != "" {
+			fmt.Fprintf(buf, "  Owner: %s\r\n", a.Owner)
+		}
+		if a.Location != "" {
+			fmt.Fprintf(buf, "  Location: %s\r\n", a.Location)
+		}
+		if len(a.Tags) > 0 {
+			fmt.Fprintf(buf, "  Tags: %s\r\n", strings.Join(a.Tags, ", "))
+		}
+		if a.Flapping {
+			buf.WriteString("  Host is flapping\r\n")
+		}
+		buf.WriteString("\r\n")
+	}
+
+	return buf.Bytes()
+}
+
+// Send sends alerts in a single message
+func (e *Email) Send(alerts []*Alert) error {
+	addr := net.JoinHostPort(e.Host, strconv.Itoa(e.Port))
+	conn, err := net.DialTimeout("tcp", addr, e.Timeout)
+	if err != nil {
+		return fmt.Errorf("could not connect to %s: %w", addr, err)
+	}
+	if err = conn.SetDeadline(time.Now().Add(e.Timeout)); err != nil {
+		conn.Close()
+		return fmt.Errorf("could not set deadline: %w", err)
+	}
+
+	c, err := smtp.NewClient(conn, e.Host)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("could not start SMTP session: %w", err)
+	}
+	defer c.Close()
+
+	if e.StartTLS {
+		if err = c.StartTLS(&tls.Config{ServerName: e.Host}); err != nil {
+			return fmt.Errorf("could not start TLS: %w", err)
+		}
+	}
+
+	if e.Username != "" {
+		if err = c.Auth(smtp.PlainAuth("", e.Username, e.Password, e.Host)); err != nil {
+			return fmt.Errorf("could not authenticate: %w", err)
+		}
+	}
+
+	if err = c.Mail(e.From); err != nil {
+		return fmt.Errorf("could not set sender: %w", err)
+	}
+	for _, to := range e.To {
+		if err = c.Rcpt(to); err != nil {
+			return fmt.Errorf("could not add recipient %s: %w", to, err)
+		}
+	}
+
+	w, err := c.Data()
+	if err != nil {
+		return fmt.Errorf("could not start message: %w", err)
+	}
+	if _, err = w.Write(e.Message(alerts)); err != nil {
+		return fmt.Errorf("could not write message: %w", err)
+	}
+	if err = w.Close(); err != nil {
+		return fmt.Errorf("could not send message: %w", err)
+	}
+
+	return c.Quit()
+}
@@ -0,0 +1,239 @@
+package main
+
+import (
+	"bufio"
+	"encoding/base64"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// smtpServer is a minimal SMTP stand-in that records the commands and message it receives.
+// Commands with a prefix in reject are answered with 550
+type smtpServer struct {
+	addr   *net.TCPAddr
+	reject []string
+
+	mu       sync.Mutex
+	commands []string
+	message  string
+}
+
+func newSMTPServer(t *testing.T, reject ...string) *smtpServer {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("could not listen: %v", err)
+	}
+	t.Cleanup(func() { l.Close() })
+
+	s := &smtpServer{addr: l.Addr().(*net.TCPAddr), reject: reject}
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(conn)
+		}
+	}()
+	return s
+}
+
+func (s *smtpServer) serve(conn net.Conn) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	reply := func(line string) { _, _ = conn.Write([]byte(line + "\r\n")) }
+
+	reply("220 localhost ESMTP")
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+
+		s.mu.Lock()
+		s.commands = append(s.commands, line)
+		s.mu.Unlock()
+
+		rejected := false
+		for _, prefix := range s.reject {
+			if strings.HasPrefix(line, prefix) {
+				rejected = true
+			}
+		}
+
+		switch cmd := strings.ToUpper(strings.SplitN(line, " ", 2)[0]); {
+		case rejected:
+			reply("550 rejected")
+		case cmd == "EHLO":
+			reply("250-localhost")
+			reply("250 AUTH PLAIN")
+		case cmd == "AUTH":
+			reply("235 authenticated")
+		case cmd == "DATA":
+			reply("354 go ahead")
+			msg := new(strings.Builder)
+			for {
+				l, err := r.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if l == ".\r\n" {
+					break
+				}
+				msg.WriteString(l)
+			}
+			s.mu.Lock()
+			s.message = msg.String()
+			s.mu.Unlock()
+			reply("250 queued")
+		case cmd == "QUIT":
+			reply("221 bye")
+			return
+		default:
+			reply("250 ok")
+		}
+	}
+}
+
+func testEmail(s *smtpServer) *Email {
+	return &Email{Host: "127.0.0.1", Port: s.addr.Port, From: "alerts@example.com", To: []string{"a@example.com", "b@example.com"}, Timeout: time.Second}
+}
+
+func TestEmailSend(t *testing.T) {
+	s := newSMTPServer(t)
+	e := testEmail(s)
+	e.Username, e.Password = "user", "secret"
+	if err := e.Validate(); err != nil {
+		t.Fatalf("could not validate email: %v", err)
+	}
+
+	if err := e.Send([]*Alert{testAlert()}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	auth := "AUTH PLAIN " + base64.StdEncoding.EncodeToString([]byte("\x00user\x00secret"))
+	for _, want := range []string{auth, "MAIL FROM:<alerts@example.com>", "RCPT TO:<a@example.com>", "RCPT TO:<b@example.com>", "DATA", "QUIT"} {
+		found := false
+		for _, cmd := range s.commands {
+			if strings.HasPrefix(cmd, want) {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("command %q not sent: %q", want, s.commands)
+		}
+	}
+
+	for _, want := range []string{
+		"Subject: [ping-dashboard] example.com is down\r\n",
+		"To: a@example.com, b@example.com\r\n",
+		"example.com is down (was up for 1m30s)\r\n",
+		"  Category: Servers\r\n",
+		"  Tags: web, prod\r\n",
+	} {
+		if !strings.Contains(s.message, want) {
+			t.Errorf("message does not contain %q:\n%s", want, s.message)
+		}
+	}
+}
+
+func TestEmailSendErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		reject string
+		err    string
+	}{
+		{name: "sender rejected", reject: "MAIL", err: "could not set sender"},
+		{name: "recipient rejected", reject: "RCPT TO:<b@example.com>", err: "could not add recipient b@example.com"},
+		{name: "message rejected", reject: "DATA", err: "could not start message"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			e := testEmail(newSMTPServer(t, test.reject))
+			if err := e.Validate(); err != nil {
+				t.Fatalf("could not validate email: %v", err)
+			}
+			err := e.Send([]*Alert{testAlert()})
+			if err == nil {
+				t.Fatalf("expected error %q", test.err)
+			}
+			if !strings.Contains(err.Error(), test.err) {
+				t.Errorf("error = %q, want %q", err, test.err)
+			}
+		})
+	}
+
+	e := &Email{Host: "127.0.0.1", Port: 1, From: "alerts@example.com", To: []string{"a@example.com"}, Timeout: time.Second}
+	if err := e.Send([]*Alert{testAlert()}); err == nil || !strings.Contains(err.Error(), "could not connect") {
+		t.Errorf("error = %v, want could not connect", err)
+	}
+}
+
+func TestEmailDigest(t *testing.T) {
+	e := &Email{From: "alerts@example.com", To: []string{"a@example.com"}}
+
+	// a host that matched two categories, and another host
+	servers, other := testAlert(), testAlert()
+	servers.Matched = []string{"Servers", "Web"}
+	other.Host, other.State, other.Time = "other.example.com", StatusUp, other.Time.Add(-time.Minute)
+
+	msg := string(e.Message([]*Alert{servers, other}))
+
+	if want := "Subject: [ping-dashboard] 2 hosts changed state: 1 down, 1 recovered\r\n"; !strings.Contains(msg, want) {
+		t.Errorf("message does not contain %q:\n%s", want, msg)
+	}
+	if n := strings.Count(msg, "example.com is down"); n != 1 {
+		t.Errorf("example.com listed %d times, want 1:\n%s", n, msg)
+	}
+	if want := "  Categories: Servers, Web\r\n"; !strings.Contains(msg, want) {
+		t.Errorf("message does not contain %q:\n%s", want, msg)
+	}
+	if strings.Index(msg, "other.example.com recovered") > strings.Index(msg, "example.com is down") {
+		t.Errorf("alerts not sorted by time:\n%s", msg)
+	}
+}
+
+func TestEmailValidate(t *testing.T) {
+	tests := []struct {
+		name  string
+		email *Email
+		valid bool
+	}{
+		{name: "valid", email: &Email{Host: "smtp.example.com", From: "a@example.com", To: []string{"b@example.com"}}, valid: true},
+		{name: "auth with starttls", email: &Email{Host: "smtp.example.com", StartTLS: true, Username: "user", From: "a@example.com", To: []string{"b@example.com"}}, valid: true},
+		{name: "auth to localhost", email: &Email{Host: "localhost", Username: "user", From: "a@example.com", To: []string{"b@example.com"}}, valid: true},
+		{name: "auth without tls", email: &Email{Host: "smtp.example.com", Username: "user", From: "a@example.com", To: []string{"b@example.com"}}},
+		{name: "missing host", email: &Email{From: "a@example.com", To: []string{"b@example.com"}}},
+		{name: "missing to", email: &Email{Host: "smtp.example.com", From: "a@example.com"}},
+		{name: "header injection", email: &Email{Host: "smtp.example.com", From: "a@example.com", To: []string{"b@example.com\r\nBcc: c@example.com"}}},
+		{name: "bad port", email: &Email{Host: "smtp.example.com", Port: 70000, From: "a@example.com", To: []string{"b@example.com"}}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.email.Validate()
+			if test.valid && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			} else if !test.valid && err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+
+	e := &Email{Host: "smtp.example.com", From: "a@example.com", To: []string{"b@example.com"}}
+	if err := e.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	if e.Port != 25 || e.Timeout != defaultSMTPTimeout {
+		t.Errorf("defaults not applied: port = %d, timeout = %v", e.Port, e.Timeout)
+	}
+}
@@ -0,0 +1,163 @@
+package main
+
+import (
+	"fmt"
+	"math/big"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// ipToInt returns ip as an integer, and the length of the address in bytes
+func ipToInt(ip net.IP) (*big.Int, int) {
+	if ip4 := ip.To4(); ip4 != nil {
+		return new(big.Int).SetBytes(ip4), net.IPv4len
+	}
+	return new(big.Int).SetBytes(ip.To16()), net.IPv6len
+}
+
+// intToIP returns n as an IP address of length size
+func intToIP(n *big.Int, size int) net.IP {
+	buf := n.Bytes()
+	ip := make(net.IP, size)
+	copy(ip[size-len(buf):], buf)
+	return ip
+}
+
+// ipRange returns the IPs from first to last, inclusive. It returns an error if there are more than max
+func ipRange(first, last net.IP, max int) ([]net.IP, error) {
+	start, size := ipToInt(first)
+	end, endSize := ipToInt(last)
+	if size != endSize {
+		return nil, fmt.Errorf("%s and %s are different address families", first, last)
+	}
+	if start.Cmp(end) > 0 {
+		return nil, fmt.Errorf("%s is after %s", first, last)
+	}
+
+	count := new(big.Int).Sub(end, start)
+	count.Add(count, big.NewInt(1))
+	if !count.IsInt64() || count.Int64() > int64(max) {
+		return nil, fmt.Errorf("expands to %s addresses, more than the maximum of %d", count, max)
+	}
+
+	ips := make([]net.IP, 0, count.Int64())
+	one := big.NewInt(1)
+	for n := start; n.Cmp(end) <= 0; n = new(big.Int).Add(n, one) {
+		ips = append(ips, intToIP(n, size))
+	}
+	return ips, nil
+}
+
+// expandCIDR returns the addresses in the CIDR network. For IPv4 networks larger than /31, the network and broadcast addresses are left out
+func expandCIDR(network *net.IPNet, max int) ([]net.IP, error) {
+	ones, bits := network.Mask.Size()
+	first, size := ipToInt(network.IP)
+	last := new(big.Int).Lsh(big.NewInt(1), uint(bits-ones))
+	last.Add(last, first)
+	last.Sub(last, big.NewInt(1))
+
+	if size == net.IPv4len && ones <= 30 {
+		first.Add(first, big.NewInt(1))
+		last.Sub(last, big.NewInt(1))
+	}
+
+	return ipRange(intToIP(first, size), intToIP(last, size), max)
+}
+
+// expandWildcard returns the addresses matching an IPv4 address with * octets. A * in the last octet matches 1-254
+func expandWildcard(pattern string, max int) ([]net.IP, error) {
+	octets := strings.Split(pattern, ".")
+	if len(octets) != 4 {
+		return nil, fmt.Errorf("invalid wildcard %q", pattern)
+	}
+
+	count := 1
+	for idx, o := range octets {
+		if o == "*" {
+			if idx == 3 {
+				count *= 254
+			} else {
+				count *= 256
+			}
+		} else if n, err := strconv.Atoi(o); err != nil || n < 0 || n > 255 {
+			return nil, fmt.Errorf("invalid wildcard %q", pattern)
+		}
+	}
+	if count > max {
+		return nil, fmt.Errorf("expands to %d addresses, more than the maximum of %d", count, max)
+	}
+
+	ips := []net.IP{make(net.IP, 0, net.IPv4len)}
+	for idx, o := range octets {
+		lo, hi := 0, 255
+		if o != "*" {
+			n, _ := strconv.Atoi(o)
+			lo, hi = n, n
+		} else if idx == 3 {
+			lo, hi = 1, 254
+		}
+
+		next := make([]net.IP, 0, len(ips)*(hi-lo+1))
+		for _, ip := range ips {
+			for n := lo; n <= hi; n++ {
+				next = append(next, append(append(make(net.IP, 0, net.IPv4len), ip...), byte(n)))
+			}
+		}
+		ips = next
+	}
+
+	return ips, nil
+}
+
+// ExpandHost returns the IPs matching host if it's a CIDR network (10.1.2.0/24), range (10.1.2.10-10.1.2.50 or 10.1.2.10-50),
+// or IPv4 wildcard (10.1.2.*). It returns nil if host isn't one of those. An error is returned if host expands to more than max addresses
+func ExpandHost(host string, max int) ([]net.IP, error) {
+	switch {
+	case strings.Contains(host, "/"):
+		_, network, err := net.ParseCIDR(host)
+		if err != nil {
+			return nil, fmt.Errorf("invalid network %q: %w", host, err)
+		}
+		return expandCIDR(network, max)
+	case strings.Contains(host, "*"):
+		return expandWildcard(host, max)
+	case strings.Contains(host, "-"):
+		parts := strings.SplitN(host, "-", 2)
+		first := net.ParseIP(parts[0])
+		if first == nil {
+			// hostnames can contain -
+			return nil, nil
+		}
+		last := net.ParseIP(parts[1])
+		// 10.1.2.10-50 is shorthand for 10.1.2.10-10.1.2.50
+		if n, err := strconv.Atoi(parts[1]); last == nil && err == nil && first.To4() != nil && n >= 0 && n <= 255 {
+			last = append(append(make(net.IP, 0, net.IPv4len), first.To4()[:3]...), byte(n))
+		}
+		if last == nil {
+			return nil, fmt.Errorf("invalid range %q", host)
+		}
+		return ipRange(first, last, max)
+	}
+
+	return nil, nil
+}
+
+// expandHost returns a copy of h for each address if h is a network, range or wildcard, or h otherwise
+func expandHost(h *Host, max int) ([]*Host, error) {
+	ips, err := ExpandHost(h.Host, max)
+	if err != nil {
+		return nil, err
+	}
+	if ips == nil {
+		return []*Host{h}, nil
+	}
+
+	expanded := make([]*Host, 0, len(ips))
+	for _, ip := range ips {
+		c := *h
+		c.Host = ip.String()
+		expanded = append(expanded, &c)
+	}
+	return expanded, nil
+}
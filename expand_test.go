@@ -0,0 +1,80 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestExpandHost(t *testing.T) {
+	tests := []struct {
+		host        string
+		max         int
+		count       int
+		first, last string
+		err         string
+	}{
+		{host: "10.0.0.0/24", max: 256, count: 254, first: "10.0.0.1", last: "10.0.0.254"},
+		{host: "10.0.0.0/30", max: 256, count: 2, first: "10.0.0.1", last: "10.0.0.2"},
+		{host: "10.0.0.0/31", max: 256, count: 2, first: "10.0.0.0", last: "10.0.0.1"},
+		{host: "10.0.0.5/32", max: 256, count: 1, first: "10.0.0.5", last: "10.0.0.5"},
+		{host: "10.0.0.7/29", max: 256, count: 6, first: "10.0.0.1", last: "10.0.0.6"},
+		{host: "2001:db8::/126", max: 256, count: 4, first: "2001:db8::", last: "2001:db8::3"},
+		{host: "10.0.0.0/16", max: 256, err: "expands to 65534 addresses, more than the maximum of 256"},
+		{host: "10.0.0.0/33", max: 256, err: "invalid network"},
+		{host: "10.0.0.300/24", max: 256, err: "invalid network"},
+
+		{host: "10.0.0.10-10.0.0.12", max: 256, count: 3, first: "10.0.0.10", last: "10.0.0.12"},
+		{host: "10.0.0.10-12", max: 256, count: 3, first: "10.0.0.10", last: "10.0.0.12"},
+		{host: "10.0.0.255-10.0.1.0", max: 256, count: 2, first: "10.0.0.255", last: "10.0.1.0"},
+		{host: "2001:db8::1-2001:db8::3", max: 256, count: 3, first: "2001:db8::1", last: "2001:db8::3"},
+		{host: "10.0.0.1-10.0.0.1", max: 256, count: 1, first: "10.0.0.1", last: "10.0.0.1"},
+		{host: "10.0.0.0-10.0.255.255", max: 256, err: "expands to 65536 addresses, more than the maximum of 256"},
+		{host: "10.0.0.10-5", max: 256, err: "10.0.0.10 is after 10.0.0.5"},
+		{host: "10.0.0.10-256", max: 256, err: "invalid range"},
+		{host: "10.0.0.1-2001:db8::1", max: 256, err: "different address families"},
+
+		{host: "10.0.0.*", max: 256, count: 254, first: "10.0.0.1", last: "10.0.0.254"},
+		{host: "10.0.*.1", max: 256, count: 256, first: "10.0.0.1", last: "10.0.255.1"},
+		{host: "10.0.*.*", max: 256, err: "expands to 65024 addresses, more than the maximum of 256"},
+		{host: "10.0.0.*.1", max: 256, err: "invalid wildcard"},
+		{host: "10.0.256.*", max: 256, err: "invalid wildcard"},
+
+		{host: "host-1.example.com", max: 256},
+		{host: "10.0.0.1", max: 256},
+		{host: "2001:db8::1", max: 256},
+	}
+
+	for _, test := range tests {
+		t.Run(test.host, func(t *testing.T) {
+			ips, err := ExpandHost(test.host, test.max)
+			if test.err != "" {
+				if err == nil {
+					t.Fatalf("expected error %q", test.err)
+				}
+				if !strings.Contains(err.Error(), test.err) {
+					t.Errorf("error = %q, want %q", err, test.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(ips) != test.count {
+				t.Fatalf("expanded to %d addresses, want %d", len(ips), test.count)
+			}
+			if test.count == 0 {
+				if ips != nil {
+					t.Errorf("expanded to %v, want nil", ips)
+				}
+				return
+			}
+			if first := ips[0].String(); first != test.first {
+				t.Errorf("first = %s, want %s", first, test.first)
+			}
+			if last := ips[len(ips)-1].String(); last != test.last {
+				t.Errorf("last = %s, want %s", last, test.last)
+			}
+		})
+	}
+}
@@ -9,6 +9,7 @@ require (
 	github.com/kelseyhightower/envconfig v1.4.0
 	github.com/korylprince/ipscan v1.0.4
 	golang.org/x/sync v0.1.0
+	golang.org/x/time v0.3.0
 	gopkg.in/yaml.v2 v2.4.0
 )
 
@@ -16,5 +17,4 @@ require (
 	github.com/felixge/httpsnoop v1.0.3 // indirect
 	github.com/go-pkgz/expirable-cache v1.0.0 // indirect
 	github.com/korylprince/go-icmpv4/v2 v2.0.2 // indirect
-	golang.org/x/time v0.3.0 // indirect
 )
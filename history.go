@@ -0,0 +1,419 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Sample kinds
+const (
+	KindDNS  = "dns"
+	KindICMP = "icmp"
+	KindTCP  = "tcp"
+	KindHTTP = "http"
+	KindTLS  = "tls"
+)
+
+const (
+	historyDayFormat = "2006-01-02"
+	historyRawPrefix = "raw-"
+	historyRollup    = "rollup-"
+	historyExt       = ".jsonl"
+)
+
+// Sample is a probe result recorded in History. Raw samples have a Count of 1.
+// Downsampled samples are the aggregate of Count raw samples: Up is the fraction of samples that were up,
+// Latency and Loss are averages, and Time is the start of the bucket
+type Sample struct {
+	Time       time.Time `json:"time"`
+	Host       string    `json:"host"`
+	Kind       string    `json:"kind"`
+	Target     string    `json:"target"`
+	Up         float64   `json:"up"`
+	Latency    float64   `json:"latency"`
+	MinLatency float64   `json:"min_latency"`
+	MaxLatency float64   `json:"max_latency"`
+	Loss       float64   `json:"loss"`
+	Count      int       `json:"count"`
+}
+
+// NewSample converts a probe result to a Sample. It returns nil for results that aren't recorded
+func NewSample(msg json.Marshaler) *Sample {
+	sample := &Sample{Time: time.Now(), Count: 1}
+	var latency time.Duration
+
+	switch m := msg.(type) {
+	case *Resolve:
+		sample.Host, sample.Kind, sample.Target = m.Hostname, KindDNS, m.Hostname
+		sample.Up = boolFloat(m.Error == nil && len(m.IPs) > 0)
+	case *Stats:
+		sample.Host, sample.Kind, sample.Target = m.Hostname, KindICMP, m.IP.String()
+		sample.Up = boolFloat(m.Status != StatusDown)
+		sample.Latency = float64(m.Avg.Microseconds())
+		sample.MinLatency = float64(m.Min.Microseconds())
+		sample.MaxLatency = float64(m.Max.Microseconds())
+		sample.Loss = m.Loss()
+		return sample
+	case *TCP:
+		sample.Host, sample.Kind, sample.Target = m.Hostname, KindTCP, m.Addr()
+		sample.Up = boolFloat(m.Error == nil)
+		latency = m.Latency
+	case *HTTP:
+		sample.Host, sample.Kind, sample.Target = m.Hostname, KindHTTP, m.URL
+		sample.Up = boolFloat(m.Error == nil)
+		latency = m.Latency
+	case *TLS:
+		sample.Host, sample.Kind, sample.Target = m.Hostname, KindTLS, m.Key()
+		sample.Up = boolFloat(m.Status != CertCritical)
+	default:
+		return nil
+	}
+
+	if sample.Up == 1 {
+		sample.Latency = float64(latency.Microseconds())
+		sample.MinLatency, sample.MaxLatency = sample.Latency, sample.Latency
+	} else {
+		sample.Loss = 100
+	}
+
+	return sample
+}
+
+func boolFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// History is an on-disk store of Samples. Samples are appended to a raw file per day.
+// Raw files older than rawRetention are downsampled to resolution sized buckets, and all files older than retention are removed
+type History struct {
+	path         string
+	retention    time.Duration
+	rawRetention time.Duration
+	resolution   time.Duration
+
+	file *os.File
+	day  string
+	mu   *sync.Mutex
+	// compactMu prevents queries from reading files while they're being downsampled
+	compactMu *sync.RWMutex
+}
+
+// NewHistory returns a new *History storing files in the directory at path, creating it if necessary
+func NewHistory(path string, retention, rawRetention, resolution time.Duration) (*History, error) {
+	if err := os.MkdirAll(path, 0755); err != nil {
+		return nil, fmt.Errorf("could not create history directory: %w", err)
+	}
+
+	if resolution <= 0 {
+		return nil, errors.New("resolution must be positive")
+	}
+
+	return &History{
+		path:         path,
+		retention:    retention,
+		rawRetention: rawRetention,
+		resolution:   resolution,
+		mu:           new(sync.Mutex),
+		compactMu:    new(sync.RWMutex),
+	}, nil
+}
+
+func (h *History) filename(prefix, day string) string {
+	return filepath.Join(h.path, prefix+day+historyExt)
+}
+
+// Record appends the sample for msg to the store, if there is one
+func (h *History) Record(msg json.Marshaler) error {
+	sample := NewSample(msg)
+	if sample == nil {
+		return nil
+	}
+
+	buf, err := json.Marshal(sample)
+	if err != nil {
+		return fmt.Errorf("could not marshal sample: %w", err)
+	}
+	buf = append(buf, '\n')
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	day := sample.Time.UTC().Format(historyDayFormat)
+	if h.file == nil || h.day != day {
+		if h.file != nil {
+			h.file.Close()
+		}
+		f, err := os.OpenFile(h.filename(historyRawPrefix, day), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+		if err != nil {
+			h.file = nil
+			return fmt.Errorf("could not open history file: %w", err)
+		}
+		h.file, h.day = f, day
+	}
+
+	if _, err = h.file.Write(buf); err != nil {
+		return fmt.Errorf("could not write sample: %w", err)
+	}
+
+	return nil
+}
+
+// readSamples calls fn with each sample in the file at path, until fn returns false.
+// If match isn't nil, lines that don't contain it are skipped without being decoded
+func readSamples(path string, match []byte, fn func(*Sample) bool) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		if match != nil && !bytes.Contains(scanner.Bytes(), match) {
+			continue
+		}
+		sample := new(Sample)
+		// skip partially written lines
+		if err = json.Unmarshal(scanner.Bytes(), sample); err != nil {
+			continue
+		}
+		if !fn(sample) {
+			break
+		}
+	}
+
+	return scanner.Err()
+}
+
+type sampleKey struct {
+	host   string
+	kind   string
+	target string
+	time   time.Time
+}
+
+// downsample aggregates the raw file for day into a rollup file and removes the raw file
+func (h *History) downsample(day string) error {
+	buckets := make(map[sampleKey]*Sample)
+	err := readSamples(h.filename(historyRawPrefix, day), nil, func(s *Sample) bool {
+		key := sampleKey{host: s.Host, kind: s.Kind, target: s.Target, time: s.Time.Truncate(h.resolution)}
+		b, ok := buckets[key]
+		if !ok {
+			b = &Sample{Time: key.time, Host: s.Host, Kind: s.Kind, Target: s.Target, MinLatency: math.MaxFloat64}
+			buckets[key] = b
+		}
+		mergeSample(b, s)
+		return true
+	})
+	if err != nil {
+		return fmt.Errorf("could not read raw samples: %w", err)
+	}
+
+	samples := make([]*Sample, 0, len(buckets))
+	for _, b := range buckets {
+		if b.MinLatency == math.MaxFloat64 {
+			b.MinLatency = 0
+		}
+		samples = append(samples, b)
+	}
+	sort.Slice(samples, func(i, j int) bool { return samples[i].Time.Before(samples[j].Time) })
+
+	tmp := h.filename(historyRollup, day) + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return fmt.Errorf("could not create rollup file: %w", err)
+	}
+	w := bufio.NewWriter(f)
+	enc := json.NewEncoder(w)
+	for _, s := range samples {
+		if err = enc.Encode(s); err != nil {
+			f.Close()
+			return fmt.Errorf("could not write rollup sample: %w", err)
+		}
+	}
+	if err = w.Flush(); err != nil {
+		f.Close()
+		return fmt.Errorf("could not write rollup file: %w", err)
+	}
+	if err = f.Close(); err != nil {
+		return fmt.Errorf("could not close rollup file: %w", err)
+	}
+
+	if err = os.Rename(tmp, h.filename(historyRollup, day)); err != nil {
+		return fmt.Errorf("could not rename rollup file: %w", err)
+	}
+
+	if err = os.Remove(h.filename(historyRawPrefix, day)); err != nil {
+		return fmt.Errorf("could not remove raw file: %w", err)
+	}
+
+	return nil
+}
+
+// mergeSample adds s to the aggregate sample b
+func mergeSample(b, s *Sample) {
+	if s.Count < 1 {
+		return
+	}
+	total := float64(b.Count + s.Count)
+	upCount := b.Up*float64(b.Count) + s.Up*float64(s.Count)
+	if upCount > 0 {
+		b.Latency = (b.Latency*b.Up*float64(b.Count) + s.Latency*s.Up*float64(s.Count)) / upCount
+	}
+	if s.Up > 0 {
+		if s.MinLatency < b.MinLatency {
+			b.MinLatency = s.MinLatency
+		}
+		if s.MaxLatency > b.MaxLatency {
+			b.MaxLatency = s.MaxLatency
+		}
+	}
+	b.Loss = (b.Loss*float64(b.Count) + s.Loss*float64(s.Count)) / total
+	b.Up = upCount / total
+	b.Count += s.Count
+}
+
+// Compact downsamples raw files older than the raw retention and removes files older than the retention
+func (h *History) Compact() error {
+	h.compactMu.Lock()
+	defer h.compactMu.Unlock()
+
+	entries, err := os.ReadDir(h.path)
+	if err != nil {
+		return fmt.Errorf("could not read history directory: %w", err)
+	}
+
+	now := time.Now().UTC()
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || !strings.HasSuffix(name, historyExt) {
+			continue
+		}
+
+		prefix := historyRawPrefix
+		if strings.HasPrefix(name, historyRollup) {
+			prefix = historyRollup
+		} else if !strings.HasPrefix(name, historyRawPrefix) {
+			continue
+		}
+
+		dayStr := strings.TrimSuffix(strings.TrimPrefix(name, prefix), historyExt)
+		day, err := time.Parse(historyDayFormat, dayStr)
+		if err != nil {
+			continue
+		}
+		// a day's file is complete once the day is over
+		end := day.Add(24 * time.Hour)
+
+		if now.Sub(end) > h.retention {
+			if err = os.Remove(filepath.Join(h.path, name)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+				return fmt.Errorf("could not remove expired history file: %w", err)
+			}
+			continue
+		}
+
+		if prefix == historyRawPrefix && now.Sub(end) > h.rawRetention {
+			if err = h.downsample(dayStr); err != nil {
+				return fmt.Errorf("could not downsample %s: %w", dayStr, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Run compacts the store every hour. Run never returns
+func (h *History) Run() {
+	for {
+		if err := h.Compact(); err != nil {
+			log.Println("could not compact history:", err)
+		}
+		time.Sleep(time.Hour)
+	}
+}
+
+// Series is the list of samples for a single probe target
+type Series struct {
+	Kind    string    `json:"kind"`
+	Target  string    `json:"target"`
+	Samples []*Sample `json:"samples"`
+}
+
+// Query returns up to limit of the earliest samples recorded for host between from and to, grouped into series by probe target.
+// Files are streamed a day at a time, and truncated is true if there were more samples than limit
+func (h *History) Query(host string, from, to time.Time, limit int) (list []*Series, truncated bool, err error) {
+	// only lines with the host are decoded
+	match, err := json.Marshal(host)
+	if err != nil {
+		return nil, false, fmt.Errorf("could not marshal host: %w", err)
+	}
+	match = append([]byte(`"host":`), match...)
+
+	series := make(map[string]*Series)
+	count := 0
+	collect := func(s *Sample) bool {
+		if s.Host != host || s.Time.Before(from) || s.Time.After(to) {
+			return true
+		}
+		if count == limit {
+			truncated = true
+			return false
+		}
+		count++
+		key := s.Kind + " " + s.Target
+		sr, ok := series[key]
+		if !ok {
+			sr = &Series{Kind: s.Kind, Target: s.Target, Samples: make([]*Sample, 0)}
+			series[key] = sr
+		}
+		sr.Samples = append(sr.Samples, s)
+		return true
+	}
+
+	h.compactMu.RLock()
+	defer h.compactMu.RUnlock()
+
+	// downsampled buckets start up to resolution before from
+	for day := from.Add(-h.resolution).UTC().Truncate(24 * time.Hour); !day.After(to) && !truncated; day = day.Add(24 * time.Hour) {
+		dayStr := day.Format(historyDayFormat)
+		for _, prefix := range []string{historyRollup, historyRawPrefix} {
+			err = readSamples(h.filename(prefix, dayStr), match, collect)
+			if err != nil && !errors.Is(err, fs.ErrNotExist) {
+				return nil, false, fmt.Errorf("could not read history for %s: %w", dayStr, err)
+			}
+			if truncated {
+				break
+			}
+		}
+	}
+
+	list = make([]*Series, 0, len(series))
+	for _, sr := range series {
+		sort.Slice(sr.Samples, func(i, j int) bool { return sr.Samples[i].Time.Before(sr.Samples[j].Time) })
+		list = append(list, sr)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].Kind != list[j].Kind {
+			return list[i].Kind < list[j].Kind
+		}
+		return list[i].Target < list[j].Target
+	})
+
+	return list, truncated, nil
+}
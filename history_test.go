@@ -0,0 +1,79 @@
+package main
+
+import (
+	"errors"
+	"net"
+	"testing"
+	"time"
+)
+
+func TestHistoryQuery(t *testing.T) {
+	h, err := NewHistory(t.TempDir(), 720*time.Hour, 48*time.Hour, 5*time.Minute)
+	if err != nil {
+		t.Fatalf("could not create history: %v", err)
+	}
+
+	// a.example.com is a prefix of the other host, so it only matches its own lines
+	for i := 0; i < 5; i++ {
+		for _, host := range []string{"a.example.com", "a.example.com.other"} {
+			if err = h.Record(&Resolve{Hostname: host, IPs: []net.IP{net.ParseIP("192.0.2.1")}}); err != nil {
+				t.Fatalf("could not record sample: %v", err)
+			}
+		}
+	}
+	if err = h.Record(&Resolve{Hostname: "a.example.com", Error: errors.New("no such host")}); err != nil {
+		t.Fatalf("could not record sample: %v", err)
+	}
+
+	now := time.Now()
+	tests := []struct {
+		name      string
+		host      string
+		from, to  time.Time
+		limit     int
+		samples   int
+		truncated bool
+	}{
+		{name: "all", host: "a.example.com", from: now.Add(-time.Hour), to: now.Add(time.Hour), limit: 100, samples: 6},
+		{name: "limit", host: "a.example.com", from: now.Add(-time.Hour), to: now.Add(time.Hour), limit: 4, samples: 4, truncated: true},
+		{name: "exact limit", host: "a.example.com", from: now.Add(-time.Hour), to: now.Add(time.Hour), limit: 6, samples: 6},
+		{name: "other host", host: "a.example.com.other", from: now.Add(-time.Hour), to: now.Add(time.Hour), limit: 100, samples: 5},
+		{name: "unknown host", host: "b.example.com", from: now.Add(-time.Hour), to: now.Add(time.Hour), limit: 100},
+		{name: "outside range", host: "a.example.com", from: now.Add(-48 * time.Hour), to: now.Add(-24 * time.Hour), limit: 100},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			series, truncated, err := h.Query(test.host, test.from, test.to, test.limit)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			samples := 0
+			for _, sr := range series {
+				for _, s := range sr.Samples {
+					if s.Host != test.host {
+						t.Errorf("sample for %s returned", s.Host)
+					}
+				}
+				samples += len(sr.Samples)
+			}
+			if samples != test.samples {
+				t.Errorf("samples = %d, want %d", samples, test.samples)
+			}
+			if truncated != test.truncated {
+				t.Errorf("truncated = %v, want %v", truncated, test.truncated)
+			}
+		})
+	}
+
+	series, _, err := h.Query("a.example.com", now.Add(-time.Hour), now.Add(time.Hour), 100)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(series) != 1 || series[0].Kind != KindDNS {
+		t.Fatalf("unexpected series: %+v", series)
+	}
+	if last := series[0].Samples[len(series[0].Samples)-1]; last.Up != 0 {
+		t.Errorf("last sample up = %v, want 0", last.Up)
+	}
+}
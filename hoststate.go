@@ -0,0 +1,490 @@
+package main
+
+import (
+	"encoding/json"
+	"net"
+	"sort"
+	"time"
+)
+
+// Host statuses
+const (
+	// StatusUnknown is used for hosts that don't have any results yet
+	StatusUnknown Status = "unknown"
+	// StatusUnreachable is used for hosts that are down because their parent is down
+	StatusUnreachable Status = "unreachable"
+)
+
+// HostState is the tracked state of a host. Status only changes after the same Observed status is seen enough times in a row
+type HostState struct {
+	Hostname string
+	Status   Status
+	// Since is the time Status last changed
+	Since time.Time
+	// Observed is the status from the latest scan
+	Observed Status
+	Flapping bool
+	// Changes are the times Status changed within the flap window
+	Changes []time.Time
+	// Cause is the root host or category that is down if Status is unreachable
+	Cause string
+	// Maintenance is true if the host is in a maintenance window
+	Maintenance bool
+
+	pending Status
+	count   int
+	// reported is the last status sent in an unsuppressed transition
+	reported Status
+}
+
+// Copy returns a deep copy of h
+func (h *HostState) Copy() *HostState {
+	c := *h
+	c.Changes = append(make([]time.Time, 0, len(h.Changes)), h.Changes...)
+	return &c
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (h *HostState) MarshalJSON() ([]byte, error) {
+	type state struct {
+		Type        string    `json:"t"`
+		Hostname    string    `json:"h"`
+		Status      Status    `json:"st"`
+		Since       time.Time `json:"since"`
+		Observed    Status    `json:"o"`
+		Flapping    bool      `json:"fl"`
+		Cause       string    `json:"c,omitempty"`
+		Maintenance bool      `json:"m"`
+	}
+
+	return json.Marshal(&state{
+		Type:        "h",
+		Hostname:    h.Hostname,
+		Status:      h.Status,
+		Since:       h.Since,
+		Observed:    h.Observed,
+		Flapping:    h.Flapping,
+		Cause:       h.Cause,
+		Maintenance: h.Maintenance,
+	})
+}
+
+// Transition is a change of a host's Status
+type Transition struct {
+	Hostname   string
+	Host       *Host
+	Categories []string
+	IPs        []net.IP
+	Status     Status
+	Previous   Status
+	Time       time.Time
+	// Duration is how long the host was in the Previous status
+	Duration time.Duration
+	Flapping bool
+	// Cause is the root host or category that is down if Status or Previous is unreachable
+	Cause string
+	// Suppressed is true if the transition shouldn't be alerted: unreachable hosts are still reported with their last status,
+	// hosts seen up for the first time aren't reported, and hosts in maintenance aren't reported until the maintenance ends
+	Suppressed bool
+}
+
+// StateConfig configures how host states change
+type StateConfig struct {
+	// FailThreshold is the amount of consecutive worse observations needed to change a host's status
+	FailThreshold int
+	// RecoverThreshold is the amount of consecutive better observations needed to change a host's status
+	RecoverThreshold int
+	// A host is flapping if its status changes at least FlapThreshold times within FlapWindow
+	FlapWindow    time.Duration
+	FlapThreshold int
+	// InMaintenance returns true if host, which is in categories, is in maintenance. If nil, no hosts are in maintenance
+	InMaintenance func(host string, categories []string) bool
+	// Hosts limits an update to these hosts if it isn't nil. Other hosts keep their state
+	Hosts map[string]struct{}
+}
+
+func (c *StateConfig) inMaintenance(host string, categories []string) bool {
+	return c.InMaintenance != nil && c.InMaintenance(host, categories)
+}
+
+func (c *StateConfig) updates(host string) bool {
+	if c.Hosts == nil {
+		return true
+	}
+	_, ok := c.Hosts[host]
+	return ok
+}
+
+// statusRank orders statuses from best to worst
+var statusRank = map[Status]int{
+	StatusUnknown:     0,
+	StatusUp:          1,
+	StatusDegraded:    2,
+	StatusDown:        3,
+	StatusUnreachable: 3,
+}
+
+// sameStatus returns true if a and b are the same status. Down and unreachable are the same status with different causes
+func sameStatus(a, b Status) bool {
+	return statusRank[a] == statusRank[b]
+}
+
+// isDown returns true if st is down or unreachable
+func isDown(st Status) bool {
+	return st == StatusDown || st == StatusUnreachable
+}
+
+// combineStatuses returns up if all statuses are up, down if all are down, degraded otherwise, or unknown if statuses is empty
+func combineStatuses(statuses []Status) Status {
+	if len(statuses) == 0 {
+		return StatusUnknown
+	}
+	up, down := 0, 0
+	for _, st := range statuses {
+		switch st {
+		case StatusUp:
+			up++
+		case StatusDown:
+			down++
+		}
+	}
+	switch {
+	case up == len(statuses):
+		return StatusUp
+	case down == len(statuses):
+		return StatusDown
+	}
+	return StatusDegraded
+}
+
+// observe returns the status of h from its latest results. The caller must hold a lock
+func (s *State) observe(h *Host) Status {
+	r, ok := s.resolves[h.Host]
+	if !ok {
+		return StatusUnknown
+	}
+	if r.Error != nil {
+		return StatusDown
+	}
+
+	statuses := make([]Status, 0)
+	for _, ip := range r.IPs {
+		if *h.ICMP {
+			if st, ok := s.stats[ip.String()]; ok {
+				statuses = append(statuses, st.Status)
+			}
+		}
+		for _, port := range h.TCP {
+			if t, ok := s.tcps[(&TCP{IP: ip, Port: port}).Addr()]; ok {
+				statuses = append(statuses, boolStatus(t.Error == nil))
+			}
+		}
+	}
+	for _, check := range h.HTTP {
+		if ht, ok := s.https[httpKey(h.Host, check.ExpandURL(h.Host))]; ok {
+			statuses = append(statuses, boolStatus(ht.Error == nil))
+		}
+	}
+	for _, check := range h.TLS {
+		if t, ok := s.tlss[check.newTLS(h.Host).Key()]; ok {
+			switch t.Status {
+			case CertOK:
+				statuses = append(statuses, StatusUp)
+			case CertWarning:
+				statuses = append(statuses, StatusDegraded)
+			default:
+				statuses = append(statuses, StatusDown)
+			}
+		}
+	}
+
+	return combineStatuses(statuses)
+}
+
+func boolStatus(up bool) Status {
+	if up {
+		return StatusUp
+	}
+	return StatusDown
+}
+
+// hostUpdate holds the state of a single UpdateHostStates call
+type hostUpdate struct {
+	config      *StateConfig
+	now         time.Time
+	hosts       map[string]*Host
+	categories  map[string][]*Host
+	hostCats    map[string][]string
+	visiting    map[string]bool
+	done        map[string]bool
+	transitions []*Transition
+}
+
+// UpdateHostStates updates every host's state from the latest results, broadcasts changed states, and returns any status transitions.
+// Hosts are updated after their parents so a down host with a down parent is marked unreachable instead
+func (s *State) UpdateHostStates(config *StateConfig) []*Transition {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u := &hostUpdate{
+		config:      config,
+		now:         time.Now(),
+		hosts:       make(map[string]*Host),
+		categories:  make(map[string][]*Host),
+		hostCats:    s.hostCategories(),
+		visiting:    make(map[string]bool),
+		done:        make(map[string]bool),
+		transitions: make([]*Transition, 0),
+	}
+	order := make([]string, 0)
+	for _, c := range s.schema.Categories() {
+		u.categories[c.Path] = append(u.categories[c.Path], c.AllHosts()...)
+		for _, h := range c.Hosts {
+			if _, ok := u.hosts[h.Host]; !ok {
+				u.hosts[h.Host] = h
+				order = append(order, h.Host)
+			}
+		}
+	}
+
+	for _, host := range order {
+		s.updateHost(u, host)
+	}
+
+	for host := range s.hostStates {
+		if _, ok := u.hosts[host]; !ok {
+			delete(s.hostStates, host)
+		}
+	}
+
+	for _, t := range u.transitions {
+		t.Flapping = s.hostStates[t.Hostname].Flapping
+	}
+	s.updateAggregates()
+
+	return u.transitions
+}
+
+// parentDown updates parent if necessary and returns true and the root cause if it's down.
+// parent is a host, or a category which is down if all its hosts are. The caller must hold a lock
+func (s *State) parentDown(u *hostUpdate, parent string) (bool, string) {
+	if _, ok := u.hosts[parent]; ok {
+		// ignore dependency cycles
+		if u.visiting[parent] {
+			return false, ""
+		}
+		s.updateHost(u, parent)
+		hs := s.hostStates[parent]
+		switch hs.Status {
+		case StatusDown:
+			return true, parent
+		case StatusUnreachable:
+			return true, hs.Cause
+		}
+		return false, ""
+	}
+
+	hosts, ok := u.categories[parent]
+	if !ok || len(hosts) == 0 {
+		return false, ""
+	}
+	for _, h := range hosts {
+		if u.visiting[h.Host] {
+			return false, ""
+		}
+		s.updateHost(u, h.Host)
+		if !isDown(s.hostStates[h.Host].Status) {
+			return false, ""
+		}
+	}
+	return true, parent
+}
+
+// updateHost updates the state of host, if it hasn't been updated already. The caller must hold a lock
+func (s *State) updateHost(u *hostUpdate, host string) {
+	if u.done[host] {
+		return
+	}
+	// hosts without a state are always updated so they can be used as parents
+	if _, ok := s.hostStates[host]; ok && !u.config.updates(host) {
+		u.done[host] = true
+		return
+	}
+	u.visiting[host] = true
+	defer func() {
+		delete(u.visiting, host)
+		u.done[host] = true
+	}()
+
+	h := u.hosts[host]
+	hs, ok := s.hostStates[host]
+	if !ok {
+		hs = &HostState{Hostname: host, Status: StatusUnknown, Since: u.now, Observed: StatusUnknown, Changes: make([]time.Time, 0), reported: StatusUnknown}
+		s.hostStates[host] = hs
+	}
+
+	prev := *hs
+	hs.Maintenance = u.config.inMaintenance(host, u.hostCats[host])
+	hs.Observed = s.observe(h)
+	cause := ""
+	if hs.Observed == StatusDown && h.Parent != "" {
+		if down, root := s.parentDown(u, h.Parent); down {
+			hs.Observed, cause = StatusUnreachable, root
+		}
+	}
+
+	// hosts without results keep their status
+	if hs.Observed == StatusUnknown || (hs.Observed == hs.Status && cause == hs.Cause) {
+		hs.pending, hs.count = "", 0
+	} else if sameStatus(hs.Observed, hs.Status) {
+		// a down host's parent went down or came back, or its root cause changed. This isn't a real change so it's applied immediately
+		s.transition(u, h, hs, cause, false)
+	} else {
+		if !sameStatus(hs.Observed, hs.pending) {
+			hs.pending, hs.count = hs.Observed, 0
+		}
+		hs.count++
+
+		threshold := u.config.RecoverThreshold
+		if statusRank[hs.Observed] > statusRank[hs.Status] {
+			threshold = u.config.FailThreshold
+		}
+
+		// the first real observation is applied immediately
+		if hs.count >= threshold || hs.Status == StatusUnknown {
+			s.transition(u, h, hs, cause, hs.Status != StatusUnknown)
+		}
+	}
+
+	// report changes that happened during maintenance once it's over
+	if !hs.Maintenance && hs.Status != StatusUnknown && hs.Status != StatusUnreachable && hs.Status != hs.reported {
+		s.report(u, h, hs)
+	}
+
+	// drop changes outside the flap window
+	for len(hs.Changes) > 0 && u.now.Sub(hs.Changes[0]) > u.config.FlapWindow {
+		hs.Changes = hs.Changes[1:]
+	}
+	hs.Flapping = u.config.FlapThreshold > 0 && len(hs.Changes) >= u.config.FlapThreshold
+
+	if hs.Status != prev.Status || hs.Observed != prev.Observed || hs.Flapping != prev.Flapping || hs.Cause != prev.Cause ||
+		hs.Maintenance != prev.Maintenance {
+		s.broadcast(hs.Copy())
+	}
+}
+
+// transition changes hs to its observed status and records the transition. If change is true, it counts towards flapping.
+// The caller must hold a lock
+func (s *State) transition(u *hostUpdate, h *Host, hs *HostState, cause string, change bool) {
+	t := &Transition{
+		Hostname:   h.Host,
+		Host:       h,
+		Categories: u.hostCats[h.Host],
+		Status:     hs.Observed,
+		Previous:   hs.Status,
+		Time:       u.now,
+		Duration:   u.now.Sub(hs.Since),
+		Cause:      cause,
+	}
+	if cause == "" {
+		t.Cause = hs.Cause
+	}
+	if r, ok := s.resolves[h.Host]; ok {
+		t.IPs = r.IPs
+	}
+
+	reported := hs.Observed
+	if reported == StatusUnreachable {
+		reported = hs.reported
+	}
+	t.Suppressed = hs.Maintenance || reported == hs.reported || (hs.reported == StatusUnknown && reported == StatusUp)
+	if !hs.Maintenance {
+		hs.reported = reported
+	}
+	u.transitions = append(u.transitions, t)
+
+	if change {
+		hs.Changes = append(hs.Changes, u.now)
+	}
+	hs.Status, hs.Since, hs.Cause = hs.Observed, u.now, cause
+	hs.pending, hs.count = "", 0
+}
+
+// report records a transition from the host's last reported status to its current status. The caller must hold a lock
+func (s *State) report(u *hostUpdate, h *Host, hs *HostState) {
+	t := &Transition{
+		Hostname:   h.Host,
+		Host:       h,
+		Categories: u.hostCats[h.Host],
+		Status:     hs.Status,
+		Previous:   hs.reported,
+		Time:       u.now,
+		Duration:   u.now.Sub(hs.Since),
+		Suppressed: hs.reported == StatusUnknown && hs.Status == StatusUp,
+	}
+	if r, ok := s.resolves[h.Host]; ok {
+		t.IPs = r.IPs
+	}
+	u.transitions = append(u.transitions, t)
+	hs.reported = hs.Status
+}
+
+// SetMaintenance updates whether each host is in maintenance without waiting for the next scan, and broadcasts changed states
+func (s *State) SetMaintenance(config *StateConfig) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	categories := s.hostCategories()
+	for host, hs := range s.hostStates {
+		m := config.inMaintenance(host, categories[host])
+		if m != hs.Maintenance {
+			hs.Maintenance = m
+			s.broadcast(hs.Copy())
+		}
+	}
+	s.updateAggregates()
+}
+
+// hostCategories returns the paths of the categories each host is in, followed by their ancestors. The caller must hold a lock
+func (s *State) hostCategories() map[string][]string {
+	categories := make(map[string][]string)
+	var walk func(cs []*Category, ancestors []string)
+	walk = func(cs []*Category, ancestors []string) {
+		for _, c := range cs {
+			paths := append([]string{c.Path}, ancestors...)
+			for _, h := range c.Hosts {
+				for _, path := range paths {
+					if !containsString(categories[h.Host], path) {
+						categories[h.Host] = append(categories[h.Host], path)
+					}
+				}
+			}
+			walk(c.Categories, paths)
+		}
+	}
+	walk(s.schema, nil)
+	return categories
+}
+
+func containsString(strs []string, str string) bool {
+	for _, s := range strs {
+		if s == str {
+			return true
+		}
+	}
+	return false
+}
+
+// HostStates returns a copy of the state of every host, sorted by hostname
+func (s *State) HostStates() []*HostState {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	states := make([]*HostState, 0, len(s.hostStates))
+	for _, hs := range s.hostStates {
+		states = append(states, hs.Copy())
+	}
+	sort.Slice(states, func(i, j int) bool { return states[i].Hostname < states[j].Hostname })
+
+	return states
+}
@@ -0,0 +1,188 @@
+package main
+
+import (
+	"fmt"
+	"net"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// hostStateStep is one scan in a host state test
+type hostStateStep struct {
+	// observed is the status each host's ping stats are given. Hosts that aren't listed don't have results
+	observed    map[string]Status
+	maintenance bool
+	// status is the expected status of each listed host after the scan
+	status   map[string]Status
+	flapping map[string]bool
+	// transitions are the expected unsuppressed transitions, as "host previous>status"
+	transitions []string
+}
+
+func TestUpdateHostStates(t *testing.T) {
+	const (
+		up          = StatusUp
+		down        = StatusDown
+		unknown     = StatusUnknown
+		unreachable = StatusUnreachable
+	)
+
+	tests := []struct {
+		name   string
+		schema string
+		config StateConfig
+		steps  []hostStateStep
+	}{
+		{
+			name:   "thresholds",
+			schema: "- category: A\n  hosts: [a]\n",
+			config: StateConfig{FailThreshold: 2, RecoverThreshold: 3},
+			steps: []hostStateStep{
+				{observed: map[string]Status{"a": up}, status: map[string]Status{"a": up}},
+				{observed: map[string]Status{"a": down}, status: map[string]Status{"a": up}},
+				// a better observation resets the count
+				{observed: map[string]Status{"a": up}, status: map[string]Status{"a": up}},
+				{observed: map[string]Status{"a": down}, status: map[string]Status{"a": up}},
+				{observed: map[string]Status{"a": down}, status: map[string]Status{"a": down}, transitions: []string{"a up>down"}},
+				{observed: map[string]Status{"a": up}, status: map[string]Status{"a": down}},
+				{observed: map[string]Status{"a": up}, status: map[string]Status{"a": down}},
+				{observed: map[string]Status{"a": up}, status: map[string]Status{"a": up}, transitions: []string{"a down>up"}},
+			},
+		},
+		{
+			name:   "first observation",
+			schema: "- category: A\n  hosts: [a, b, c]\n",
+			config: StateConfig{FailThreshold: 3, RecoverThreshold: 3},
+			steps: []hostStateStep{
+				// the first observation is applied immediately, but hosts seen up for the first time aren't alerted
+				{
+					observed:    map[string]Status{"a": up, "b": down},
+					status:      map[string]Status{"a": up, "b": down, "c": unknown},
+					transitions: []string{"b unknown>down"},
+				},
+				// hosts without results keep their status
+				{observed: map[string]Status{"c": up}, status: map[string]Status{"a": up, "b": down, "c": up}},
+			},
+		},
+		{
+			name:   "flapping",
+			schema: "- category: A\n  hosts: [a]\n",
+			config: StateConfig{FailThreshold: 1, RecoverThreshold: 1, FlapWindow: time.Hour, FlapThreshold: 3},
+			steps: []hostStateStep{
+				{observed: map[string]Status{"a": up}, flapping: map[string]bool{"a": false}},
+				{observed: map[string]Status{"a": down}, flapping: map[string]bool{"a": false}, transitions: []string{"a up>down"}},
+				{observed: map[string]Status{"a": up}, flapping: map[string]bool{"a": false}, transitions: []string{"a down>up"}},
+				{observed: map[string]Status{"a": down}, flapping: map[string]bool{"a": true}, transitions: []string{"a up>down"}},
+				{observed: map[string]Status{"a": down}, status: map[string]Status{"a": down}, flapping: map[string]bool{"a": true}},
+			},
+		},
+		{
+			name:   "maintenance replay",
+			schema: "- category: A\n  hosts: [a, b]\n",
+			config: StateConfig{FailThreshold: 1, RecoverThreshold: 1},
+			steps: []hostStateStep{
+				{observed: map[string]Status{"a": up, "b": up}},
+				{observed: map[string]Status{"a": down, "b": down}, maintenance: true, status: map[string]Status{"a": down, "b": down}},
+				// b recovered before the window ended, so nothing is sent for it
+				{observed: map[string]Status{"a": down, "b": up}, maintenance: true, status: map[string]Status{"a": down, "b": up}},
+				{observed: map[string]Status{"a": down, "b": up}, status: map[string]Status{"a": down, "b": up}, transitions: []string{"a up>down"}},
+				{observed: map[string]Status{"a": down, "b": up}},
+			},
+		},
+		{
+			name: "unreachable",
+			schema: `
+- category: Site
+  parent: router
+  hosts: [router, a]
+- category: Remote
+  parent: Site
+  hosts: [b]
+`,
+			config: StateConfig{FailThreshold: 1, RecoverThreshold: 1},
+			steps: []hostStateStep{
+				{observed: map[string]Status{"router": up, "a": up, "b": up}},
+				// b's parent category isn't down until all its hosts are
+				{
+					observed:    map[string]Status{"router": down, "a": up, "b": down},
+					status:      map[string]Status{"router": down, "a": up, "b": down},
+					transitions: []string{"router up>down", "b up>down"},
+				},
+				{
+					observed: map[string]Status{"router": down, "a": down, "b": down},
+					// b was already alerted down, so becoming unreachable isn't alerted
+					status: map[string]Status{"router": down, "a": unreachable, "b": unreachable},
+				},
+				// a is down on its own once its parent is back
+				{
+					observed:    map[string]Status{"router": up, "a": down, "b": up},
+					status:      map[string]Status{"router": up, "a": down, "b": up},
+					transitions: []string{"router down>up", "a unreachable>down", "b unreachable>up"},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			schema, err := UnmarshalSchema(strings.NewReader(test.schema), 256)
+			if err != nil {
+				t.Fatalf("could not parse schema: %v", err)
+			}
+			s := NewState(16)
+			s.SetSchema(schema)
+
+			ips := make(map[string]net.IP)
+			for idx, step := range test.steps {
+				for host, status := range step.observed {
+					ip, ok := ips[host]
+					if !ok {
+						ip = net.IPv4(192, 0, 2, byte(len(ips)+1))
+						ips[host] = ip
+					}
+					if err = s.Update(&Resolve{Hostname: host, IPs: []net.IP{ip}}); err != nil {
+						t.Fatal(err)
+					}
+					if err = s.Update(&Stats{Hostname: host, IP: ip, Sent: 1, Status: status}); err != nil {
+						t.Fatal(err)
+					}
+				}
+
+				config := test.config
+				maintenance := step.maintenance
+				config.InMaintenance = func(string, []string) bool { return maintenance }
+
+				transitions := make([]string, 0)
+				for _, tr := range s.UpdateHostStates(&config) {
+					if !tr.Suppressed {
+						transitions = append(transitions, fmt.Sprintf("%s %s>%s", tr.Hostname, tr.Previous, tr.Status))
+					}
+				}
+				want := step.transitions
+				if want == nil {
+					want = []string{}
+				}
+				if !reflect.DeepEqual(transitions, want) {
+					t.Errorf("step %d: transitions = %q, want %q", idx, transitions, want)
+				}
+
+				states := make(map[string]*HostState)
+				for _, hs := range s.HostStates() {
+					states[hs.Hostname] = hs
+				}
+				for host, status := range step.status {
+					if states[host].Status != status {
+						t.Errorf("step %d: %s status = %s, want %s", idx, host, states[host].Status, status)
+					}
+				}
+				for host, flapping := range step.flapping {
+					if states[host].Flapping != flapping {
+						t.Errorf("step %d: %s flapping = %v, want %v", idx, host, states[host].Flapping, flapping)
+					}
+				}
+			}
+		})
+	}
+}
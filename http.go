@@ -1,11 +1,10 @@
 package main
 
 import (
-	"bytes"
 	"crypto/subtle"
 	"fmt"
 	"net/http"
-	"os"
+	"sync/atomic"
 	"time"
 
 	"github.com/gorilla/websocket"
@@ -26,6 +25,9 @@ func (s *Service) AuthHandler() http.Handler {
 			subtle.ConstantTimeCompare(user, []byte(u)) != 1 ||
 			subtle.ConstantTimeEq(passLen, int32(len([]byte(p)))) != 1 ||
 			subtle.ConstantTimeCompare(pass, []byte(p)) != 1 {
+			if ok {
+				atomic.AddInt64(&s.counters.authFailures, 1)
+			}
 			w.Header().Set("WWW-Authenticate", "Basic")
 			w.WriteHeader(http.StatusUnauthorized)
 			return
@@ -38,6 +40,7 @@ func (s *Service) AuthHandler() http.Handler {
 			Path:     "/",
 			Expires:  time.Now().Add(s.Config.SessionDuration),
 			HttpOnly: true,
+			SameSite: http.SameSiteStrictMode,
 		})
 		http.Redirect(w, r, "/", http.StatusTemporaryRedirect)
 	})
@@ -66,29 +69,29 @@ func (s *Service) RequireCookieAuth(next, unauth http.Handler) http.Handler {
 			Path:     "/",
 			Expires:  time.Now().Add(s.Config.SessionDuration),
 			HttpOnly: true,
+			SameSite: http.SameSiteStrictMode,
 		})
 
 		next.ServeHTTP(w, r)
 	})
 }
 
-// RequireAuth is an HTTP middleware that verifies posted basic authentication
-func (s *Service) RequireAuth(next http.Handler) http.Handler {
+// RequireBasicAuth is an HTTP middleware that verifies basic authentication
+func (s *Service) RequireBasicAuth(next http.Handler) http.Handler {
 	user := []byte(s.Config.Username)
 	userLen := int32(len(user))
 	pass := []byte(s.Config.Password)
 	passLen := int32(len(pass))
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if r.Method != http.MethodPost {
-			w.WriteHeader(http.StatusMethodNotAllowed)
-			return
-		}
 		u, p, ok := r.BasicAuth()
 		if !ok ||
 			subtle.ConstantTimeEq(userLen, int32(len([]byte(u)))) != 1 ||
 			subtle.ConstantTimeCompare(user, []byte(u)) != 1 ||
 			subtle.ConstantTimeEq(passLen, int32(len([]byte(p)))) != 1 ||
 			subtle.ConstantTimeCompare(pass, []byte(p)) != 1 {
+			if ok {
+				atomic.AddInt64(&s.counters.authFailures, 1)
+			}
 			w.Header().Set("WWW-Authenticate", "Basic")
 			w.WriteHeader(http.StatusUnauthorized)
 			return
@@ -98,6 +101,31 @@ func (s *Service) RequireAuth(next http.Handler) http.Handler {
 	})
 }
 
+// RequireAuth is an HTTP middleware that verifies posted basic authentication
+func (s *Service) RequireAuth(next http.Handler) http.Handler {
+	basic := s.RequireBasicAuth(next)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		basic.ServeHTTP(w, r)
+	})
+}
+
+// ReadOnlyHandler is an HTTP middleware that uses the write handler for requests that can change state (anything but GET or HEAD)
+func ReadOnlyHandler(next, write http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			write.ServeHTTP(w, r)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
 // RejectAuthRedirect redirects the client to the authentication handler
 func (s *Service) RejectAuthRedirect() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -123,25 +151,11 @@ func (s *Service) RejectAuthWebsocket() http.Handler {
 	})
 }
 
-// HandlePing returns an http.Handler that pings hosts and returns the information via a websocket
+// HandlePing returns an http.Handler that streams the monitored hosts' state via a websocket
 func (s *Service) HandlePing() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		l := r.Context().Value(ContextKeyLog).(*Log)
 
-		buf, err := os.ReadFile(s.Config.HostsPath)
-		if err != nil {
-			w.WriteHeader(http.StatusInternalServerError)
-			l.Error = &Error{fmt.Errorf("could not read hosts file: %w", err)}
-			return
-		}
-
-		schema, err := UnmarshalSchema(bytes.NewBuffer(buf))
-		if err != nil {
-			w.WriteHeader(http.StatusInternalServerError)
-			l.Error = &Error{fmt.Errorf("could not parse hosts file: %w", err)}
-			return
-		}
-
 		c, err := (&websocket.Upgrader{}).Upgrade(w, r, nil)
 		if err != nil {
 			w.WriteHeader(http.StatusInternalServerError)
@@ -149,7 +163,7 @@ func (s *Service) HandlePing() http.Handler {
 			return
 		}
 
-		if err = s.HandleConn(c, schema); err != nil {
+		if err = s.HandleConn(c); err != nil {
 			w.WriteHeader(http.StatusInternalServerError)
 			l.Error = &Error{fmt.Errorf("could not finish websocket conn: %w", err)}
 			return
@@ -0,0 +1,184 @@
+package main
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// maxHTTPBody is the maximum amount of a response body that is matched against HTTPCheck.Body
+const maxHTTPBody = 1 << 20
+
+// HTTPCheck configures an HTTP(S) probe. "{host}" in URL is replaced with the hostname being probed.
+// If URL is empty, "http://{host}/" is used. If Status is empty, any 2xx status is expected. If Timeout is zero, Config.Timeout is used.
+// A check must be validated before it's used
+type HTTPCheck struct {
+	URL             string            `yaml:"url"`
+	Status          []int             `yaml:"status"`
+	Body            string            `yaml:"body"`
+	Timeout         time.Duration     `yaml:"timeout"`
+	FollowRedirects bool              `yaml:"follow_redirects"`
+	Insecure        bool              `yaml:"insecure"`
+	Headers         map[string]string `yaml:"headers"`
+
+	// body is the compiled Body, or nil if Body is empty
+	body *regexp.Regexp
+}
+
+// Validate returns an error if the check is invalid
+func (c *HTTPCheck) Validate() error {
+	if !strings.HasPrefix(c.URL, "http://") && !strings.HasPrefix(c.URL, "https://") {
+		return fmt.Errorf("invalid url %q: must start with http:// or https://", c.URL)
+	}
+	c.body = nil
+	if c.Body != "" {
+		re, err := regexp.Compile(c.Body)
+		if err != nil {
+			return fmt.Errorf("invalid body regex: %w", err)
+		}
+		c.body = re
+	}
+	for _, s := range c.Status {
+		if s < 100 || s > 599 {
+			return fmt.Errorf("invalid status %d", s)
+		}
+	}
+	return nil
+}
+
+// ExpandURL returns the check's URL for hostname
+func (c *HTTPCheck) ExpandURL(hostname string) string {
+	return strings.ReplaceAll(c.URL, "{host}", hostname)
+}
+
+func (c *HTTPCheck) expected(status int) bool {
+	if len(c.Status) == 0 {
+		return status >= 200 && status <= 299
+	}
+	for _, s := range c.Status {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
+// HTTP is the result of an HTTP probe
+type HTTP struct {
+	Hostname string
+	URL      string
+	Status   int
+	Latency  time.Duration
+	TLS      *tls.ConnectionState
+	Error    error
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (h *HTTP) MarshalJSON() ([]byte, error) {
+	type httpTLS struct {
+		Version     string     `json:"v"`
+		CipherSuite string     `json:"cs"`
+		Subject     string     `json:"sub,omitempty"`
+		Expires     *time.Time `json:"ex,omitempty"`
+	}
+	type http struct {
+		Type     string   `json:"t"`
+		Hostname string   `json:"h"`
+		URL      string   `json:"u"`
+		Status   int      `json:"s,omitempty"`
+		Latency  int64    `json:"l"`
+		TLS      *httpTLS `json:"tls,omitempty"`
+		Error    string   `json:"e,omitempty"`
+	}
+
+	ht := &http{Type: "w", Hostname: h.Hostname, URL: h.URL, Status: h.Status, Latency: h.Latency.Microseconds()}
+
+	if h.TLS != nil {
+		ht.TLS = &httpTLS{
+			Version:     tls.VersionName(h.TLS.Version),
+			CipherSuite: tls.CipherSuiteName(h.TLS.CipherSuite),
+		}
+		if len(h.TLS.PeerCertificates) > 0 {
+			ht.TLS.Subject = h.TLS.PeerCertificates[0].Subject.String()
+			ht.TLS.Expires = &h.TLS.PeerCertificates[0].NotAfter
+		}
+	}
+
+	if h.Error != nil {
+		ht.Error = h.Error.Error()
+	}
+
+	return json.Marshal(ht)
+}
+
+// ProbeHTTP performs check against hostname and returns the result. timeout is used if check doesn't set its own
+func ProbeHTTP(hostname string, check *HTTPCheck, timeout time.Duration) *HTTP {
+	h := &HTTP{Hostname: hostname, URL: check.ExpandURL(hostname)}
+
+	if check.Timeout != 0 {
+		timeout = check.Timeout
+	}
+
+	client := &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			Proxy:             http.ProxyFromEnvironment,
+			TLSClientConfig:   &tls.Config{InsecureSkipVerify: check.Insecure},
+			DisableKeepAlives: true,
+		},
+	}
+	if !check.FollowRedirects {
+		client.CheckRedirect = func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		}
+	}
+
+	req, err := http.NewRequest(http.MethodGet, h.URL, nil)
+	if err != nil {
+		h.Error = fmt.Errorf("could not create request: %w", err)
+		return h
+	}
+	for k, v := range check.Headers {
+		if strings.EqualFold(k, "Host") {
+			req.Host = v
+			continue
+		}
+		req.Header.Set(k, v)
+	}
+
+	start := time.Now()
+	resp, err := client.Do(req)
+	if err != nil {
+		h.Latency = time.Since(start)
+		h.Error = fmt.Errorf("could not complete request: %w", err)
+		return h
+	}
+	defer resp.Body.Close()
+
+	h.Status = resp.StatusCode
+	h.TLS = resp.TLS
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxHTTPBody))
+	h.Latency = time.Since(start)
+	if err != nil {
+		h.Error = fmt.Errorf("could not read body: %w", err)
+		return h
+	}
+
+	if !check.expected(resp.StatusCode) {
+		h.Error = fmt.Errorf("unexpected status: %s", resp.Status)
+		return h
+	}
+
+	if check.body != nil && !check.body.Match(body) {
+		h.Error = fmt.Errorf("body did not match %q", check.Body)
+		return h
+	}
+
+	return h
+}
@@ -0,0 +1,187 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func newHTTPTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/ok", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "status: healthy")
+	})
+	mux.HandleFunc("/error", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "status: broken", http.StatusInternalServerError)
+	})
+	mux.HandleFunc("/missing", func(w http.ResponseWriter, r *http.Request) {
+		http.NotFound(w, r)
+	})
+	mux.HandleFunc("/redirect", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/ok", http.StatusFound)
+	})
+	mux.HandleFunc("/headers", func(w http.ResponseWriter, r *http.Request) {
+		if r.Host != "vhost.example.com" || r.Header.Get("X-Check") != "ping-dashboard" {
+			http.Error(w, "missing headers", http.StatusBadRequest)
+			return
+		}
+		fmt.Fprint(w, "ok")
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestProbeHTTP(t *testing.T) {
+	srv := newHTTPTestServer(t)
+
+	tests := []struct {
+		name   string
+		check  *HTTPCheck
+		status int
+		err    string
+	}{
+		{name: "2xx by default", check: &HTTPCheck{URL: srv.URL + "/ok"}, status: http.StatusOK},
+		{name: "5xx by default", check: &HTTPCheck{URL: srv.URL + "/error"}, status: http.StatusInternalServerError, err: "unexpected status: 500"},
+		{name: "status list", check: &HTTPCheck{URL: srv.URL + "/missing", Status: []int{200, 404}}, status: http.StatusNotFound},
+		{name: "status not in list", check: &HTTPCheck{URL: srv.URL + "/ok", Status: []int{204}}, status: http.StatusOK, err: "unexpected status: 200"},
+		{name: "body match", check: &HTTPCheck{URL: srv.URL + "/ok", Body: "status: (healthy|ok)"}, status: http.StatusOK},
+		{name: "body mismatch", check: &HTTPCheck{URL: srv.URL + "/ok", Body: "^ok$"}, status: http.StatusOK, err: `body did not match "^ok$"`},
+		{name: "body not checked on bad status", check: &HTTPCheck{URL: srv.URL + "/error", Body: "broken"}, status: http.StatusInternalServerError, err: "unexpected status: 500"},
+		{name: "redirect not followed", check: &HTTPCheck{URL: srv.URL + "/redirect"}, status: http.StatusFound, err: "unexpected status: 302"},
+		{name: "redirect status expected", check: &HTTPCheck{URL: srv.URL + "/redirect", Status: []int{302}}, status: http.StatusFound},
+		{name: "redirect followed", check: &HTTPCheck{URL: srv.URL + "/redirect", FollowRedirects: true, Body: "healthy"}, status: http.StatusOK},
+		{name: "headers", check: &HTTPCheck{URL: srv.URL + "/headers", Headers: map[string]string{"Host": "vhost.example.com", "X-Check": "ping-dashboard"}}, status: http.StatusOK},
+		{name: "missing headers", check: &HTTPCheck{URL: srv.URL + "/headers"}, status: http.StatusBadRequest, err: "unexpected status: 400"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if err := test.check.Validate(); err != nil {
+				t.Fatalf("could not validate check: %v", err)
+			}
+			h := ProbeHTTP("example.com", test.check, time.Second)
+			if h.Status != test.status {
+				t.Errorf("status = %d, want %d", h.Status, test.status)
+			}
+			switch {
+			case test.err == "" && h.Error != nil:
+				t.Errorf("unexpected error: %v", h.Error)
+			case test.err != "" && h.Error == nil:
+				t.Errorf("expected error %q", test.err)
+			case test.err != "" && !strings.Contains(h.Error.Error(), test.err):
+				t.Errorf("error = %q, want %q", h.Error, test.err)
+			}
+		})
+	}
+}
+
+func TestProbeHTTPExpandURL(t *testing.T) {
+	srv := newHTTPTestServer(t)
+	u, err := url.Parse(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	check := &HTTPCheck{URL: "http://{host}/ok"}
+	if err = check.Validate(); err != nil {
+		t.Fatalf("could not validate check: %v", err)
+	}
+	h := ProbeHTTP(u.Host, check, time.Second)
+	if h.Error != nil {
+		t.Fatalf("unexpected error: %v", h.Error)
+	}
+	if h.URL != srv.URL+"/ok" {
+		t.Errorf("url = %q, want %q", h.URL, srv.URL+"/ok")
+	}
+}
+
+func TestProbeHTTPTLS(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer srv.Close()
+
+	check := &HTTPCheck{URL: srv.URL}
+	if err := check.Validate(); err != nil {
+		t.Fatalf("could not validate check: %v", err)
+	}
+	if h := ProbeHTTP("example.com", check, time.Second); h.Error == nil {
+		t.Error("expected error for untrusted certificate")
+	}
+
+	check.Insecure = true
+	h := ProbeHTTP("example.com", check, time.Second)
+	if h.Error != nil {
+		t.Fatalf("unexpected error: %v", h.Error)
+	}
+	if h.TLS == nil || len(h.TLS.PeerCertificates) == 0 {
+		t.Error("expected TLS connection state")
+	}
+}
+
+func TestProbeHTTPTimeout(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+	}))
+	defer srv.Close()
+
+	check := &HTTPCheck{URL: srv.URL, Timeout: 50 * time.Millisecond}
+	if err := check.Validate(); err != nil {
+		t.Fatalf("could not validate check: %v", err)
+	}
+	if h := ProbeHTTP("example.com", check, time.Second); h.Error == nil {
+		t.Error("expected timeout error")
+	}
+}
+
+func TestHTTPCheckValidate(t *testing.T) {
+	tests := []struct {
+		name  string
+		check *HTTPCheck
+		valid bool
+	}{
+		{name: "valid", check: &HTTPCheck{URL: "https://{host}/health", Status: []int{200, 301}, Body: "ok"}, valid: true},
+		{name: "bad scheme", check: &HTTPCheck{URL: "ftp://{host}/"}},
+		{name: "bad body regex", check: &HTTPCheck{URL: "http://{host}/", Body: "("}},
+		{name: "bad status", check: &HTTPCheck{URL: "http://{host}/", Status: []int{99}}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.check.Validate()
+			if test.valid && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			} else if !test.valid && err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
+
+func TestProbeModuleHostConcurrent(t *testing.T) {
+	srv := newHTTPTestServer(t)
+
+	m := &ProbeModule{HTTP: []*HTTPCheck{{URL: srv.URL + "/ok", Body: "healthy"}}}
+	if err := m.validate(); err != nil {
+		t.Fatalf("could not validate module: %v", err)
+	}
+
+	// run with -race: probes share the module's checks, so host must not change them
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			h := m.host("example.com")
+			if r := ProbeHTTP(h.Host, h.HTTP[0], time.Second); r.Error != nil {
+				t.Errorf("unexpected error: %v", r.Error)
+			}
+		}()
+	}
+	wg.Wait()
+}
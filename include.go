@@ -0,0 +1,361 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// schemaFile is a hosts file read by a schemaLoader
+type schemaFile struct {
+	// path is empty if the schema wasn't read from a file
+	path  string
+	lines []string
+}
+
+// position returns the file and line as path:line
+func (f *schemaFile) position(line int) string {
+	switch {
+	case f.path == "" && line == 0:
+		return ""
+	case f.path == "":
+		return fmt.Sprintf("line %d", line)
+	case line == 0:
+		return f.path
+	}
+	return fmt.Sprintf("%s:%d", f.path, line)
+}
+
+// yamlValues returns the scalar values on a line of yaml
+func yamlValues(line string) []string {
+	if idx := strings.Index(line, " #"); idx != -1 {
+		line = line[:idx]
+	}
+
+	values := make([]string, 0)
+	for _, part := range strings.FieldsFunc(line, func(r rune) bool { return strings.ContainsRune(",[]{}", r) }) {
+		part = strings.TrimPrefix(strings.TrimSpace(part), "- ")
+		if idx := strings.Index(part, ": "); idx != -1 {
+			part = part[idx+2:]
+		}
+		part = strings.TrimSpace(part)
+		if unquoted, err := strconv.Unquote(part); err == nil {
+			part = unquoted
+		} else {
+			part = strings.Trim(part, "'")
+		}
+		values = append(values, part)
+	}
+	return values
+}
+
+// line returns the number of the first line at or after line start that has a value equal to value and, if key isn't empty, is for key.
+// It returns 0 if there isn't one. yaml.v2 doesn't report where decoded values came from, so this scans the text and is best effort:
+// values written across several lines, or with anchors or unusual quoting, might not be found or might match an earlier line
+func (f *schemaFile) line(start int, key, value string) int {
+	if start < 1 {
+		start = 1
+	}
+	for idx := start - 1; idx < len(f.lines); idx++ {
+		l := strings.TrimPrefix(strings.TrimSpace(f.lines[idx]), "- ")
+		if key != "" && !strings.HasPrefix(l, key+":") {
+			continue
+		}
+		for _, v := range yamlValues(l) {
+			if v == value {
+				return idx + 1
+			}
+		}
+	}
+	return 0
+}
+
+// location is where a category was defined
+type location struct {
+	file *schemaFile
+	line int
+}
+
+// String returns the location as path:line
+func (l location) String() string {
+	return l.file.position(l.line)
+}
+
+// schemaErrors are all of the errors found in a schema
+type schemaErrors []error
+
+func (e schemaErrors) Error() string {
+	if len(e) == 1 {
+		return e[0].Error()
+	}
+	msgs := make([]string, 0, len(e))
+	for _, err := range e {
+		msgs = append(msgs, err.Error())
+	}
+	return fmt.Sprintf("%d errors: %s", len(e), strings.Join(msgs, "; "))
+}
+
+// yamlLine matches the line number at the start of yaml.v2 errors
+var yamlLine = regexp.MustCompile(`^(?:yaml: )?line (\d+): `)
+
+// yamlUnknownField matches yaml.v2 errors for unknown fields in strict mode
+var yamlUnknownField = regexp.MustCompile(`^field (\S+) not found in type main\.(\w+)$`)
+
+// yamlTypes are the names used in errors for the types in the schema
+var yamlTypes = map[string]string{"Category": "category", "HTTPCheck": "http check", "TLSCheck": "tls check"}
+
+// yamlError returns msg, a yaml.v2 error message in f, with its line number moved to the file position
+func (f *schemaFile) yamlError(msg string) error {
+	line := 0
+	if m := yamlLine.FindStringSubmatch(msg); m != nil {
+		line, _ = strconv.Atoi(m[1])
+		msg = msg[len(m[0]):]
+	}
+	if m := yamlUnknownField.FindStringSubmatch(msg); m != nil {
+		typ, ok := yamlTypes[m[2]]
+		if !ok {
+			typ = strings.ToLower(m[2])
+		}
+		msg = fmt.Sprintf("unknown field %q in %s", m[1], typ)
+	}
+	if pos := f.position(line); pos != "" {
+		msg = pos + ": " + msg
+	}
+	return errors.New(msg)
+}
+
+// schemaLoader loads a hosts file and the files it includes, and reports errors with the file and line they're at
+type schemaLoader struct {
+	maxExpand int
+	// if strict is true, unknown fields and duplicate keys are errors
+	strict bool
+	// paths are the files and directories the schema was loaded from
+	paths []string
+	// included is where each file was included, keyed by its absolute path
+	included map[string]string
+	// locations are where each category or include was defined
+	locations map[*Category]location
+}
+
+func newSchemaLoader(maxExpand int) *schemaLoader {
+	return &schemaLoader{maxExpand: maxExpand, paths: make([]string, 0), included: make(map[string]string), locations: make(map[*Category]location)}
+}
+
+// loadSchema reads, parses and validates the schema at path and the files it includes
+func (l *schemaLoader) loadSchema(path string) (Schema, error) {
+	s, err := l.load(path, "")
+	if err != nil {
+		return nil, fmt.Errorf("could not load hosts file: %w", err)
+	}
+	if err = l.prepare(s); err != nil {
+		return nil, fmt.Errorf("could not load hosts file: %w", err)
+	}
+
+	return s, nil
+}
+
+// load reads the file at path and the files it includes. includedAt is where the file was included, or empty for the hosts file
+func (l *schemaLoader) load(path, includedAt string) ([]*Category, error) {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return nil, fmt.Errorf("%s: could not resolve path %s: %w", includedAt, path, err)
+	}
+	if first, ok := l.included[abs]; ok {
+		if first == "" {
+			return nil, fmt.Errorf("%s: can't include the hosts file %s", includedAt, path)
+		}
+		return nil, fmt.Errorf("%s: %s is already included at %s", includedAt, path, first)
+	}
+	l.included[abs] = includedAt
+	l.paths = append(l.paths, path)
+
+	buf, err := os.ReadFile(path)
+	if err != nil {
+		if includedAt == "" {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%s: could not read included file: %w", includedAt, err)
+	}
+
+	return l.decode(&schemaFile{path: path}, buf)
+}
+
+// decode parses buf, the contents of f, and loads the files it includes
+func (l *schemaLoader) decode(f *schemaFile, buf []byte) ([]*Category, error) {
+	f.lines = strings.Split(string(buf), "\n")
+
+	s := make(Schema, 0)
+	dec := yaml.NewDecoder(bytes.NewBuffer(buf))
+	dec.SetStrict(l.strict)
+	if err := dec.Decode(&s); err != nil && err != io.EOF {
+		// type errors are reported separately for each line
+		typeErr := new(yaml.TypeError)
+		if !errors.As(err, &typeErr) {
+			return nil, f.yamlError(err.Error())
+		}
+		errs := make(schemaErrors, 0, len(typeErr.Errors))
+		for _, msg := range typeErr.Errors {
+			errs = append(errs, f.yamlError(msg))
+		}
+		return nil, errs
+	}
+
+	// categories are in the order they appear in the file
+	next := 1
+	var locate func(categories []*Category)
+	locate = func(categories []*Category) {
+		for _, c := range categories {
+			key, value := "category", c.Category
+			if c.Include != "" {
+				key, value = "include", c.Include
+			}
+			line := f.line(next, key, value)
+			if line != 0 {
+				next = line + 1
+			}
+			l.locations[c] = location{file: f, line: line}
+			locate(c.Categories)
+		}
+	}
+	locate(s)
+
+	return l.include(f, s)
+}
+
+// include replaces include entries in categories with the categories in the files they match
+func (l *schemaLoader) include(f *schemaFile, categories []*Category) ([]*Category, error) {
+	included := make([]*Category, 0, len(categories))
+	for _, c := range categories {
+		if c.Include == "" {
+			nested, err := l.include(f, c.Categories)
+			if err != nil {
+				return nil, err
+			}
+			c.Categories = nested
+			included = append(included, c)
+			continue
+		}
+
+		loc := l.locations[c]
+		if c.Category != "" || c.Hosts != nil || c.Categories != nil {
+			return nil, fmt.Errorf("%s: include can't be used with category, hosts or categories", loc)
+		}
+
+		pattern := c.Include
+		if !filepath.IsAbs(pattern) {
+			pattern = filepath.Join(filepath.Dir(f.path), pattern)
+		}
+
+		paths := []string{pattern}
+		if strings.ContainsAny(pattern, "*?[") {
+			matches, err := filepath.Glob(pattern)
+			if err != nil {
+				return nil, fmt.Errorf("%s: invalid include %q: %w", loc, c.Include, err)
+			}
+			sort.Strings(matches)
+			paths = matches
+			// watch the directory so new files are noticed
+			l.paths = append(l.paths, filepath.Dir(pattern))
+		}
+
+		for _, path := range paths {
+			categories, err := l.load(path, loc.String())
+			if err != nil {
+				return nil, err
+			}
+			included = append(included, categories...)
+		}
+	}
+	return included, nil
+}
+
+// prepare applies options to and validates s, and checks for duplicate categories and hosts. If there are any errors, a schemaErrors is returned
+func (l *schemaLoader) prepare(s Schema) error {
+	errs := make(schemaErrors, 0)
+	report := func(err error) {
+		errs = append(errs, l.locate(err))
+	}
+
+	for _, c := range s {
+		prepareCategory(c, nil, l.maxExpand, report)
+	}
+
+	seen := make(map[string]*Category)
+	for _, c := range s.Categories() {
+		// categories without a path already have errors
+		if c.Path == "" {
+			continue
+		}
+		if first, ok := seen[c.Path]; ok {
+			report(fmt.Errorf("%s: duplicate category %q, first defined at %s", l.locations[c], c.Path, l.locations[first]))
+			continue
+		}
+		seen[c.Path] = c
+	}
+
+	// a file can list a host in more than one category, but different files are usually owned by different teams, so they can't list the same host
+	hosts := make(map[string]*Category)
+	for _, c := range s.Categories() {
+		if c.Path == "" {
+			continue
+		}
+		for _, h := range c.Hosts {
+			first, ok := hosts[h.Host]
+			if !ok {
+				hosts[h.Host] = c
+				continue
+			}
+			if loc := l.locations[first]; loc.file != l.locations[c].file {
+				if line := loc.file.line(loc.line, "", h.Host); line != 0 {
+					loc.line = line
+				}
+				report(&entryError{Category: c, Host: h.Host, Err: fmt.Errorf("already in category %q at %s", first.Path, loc)})
+			}
+		}
+	}
+
+	validateParents(s, report)
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// locate adds the file and line an *entryError happened at to err
+func (l *schemaLoader) locate(err error) error {
+	e := new(entryError)
+	if !errors.As(err, &e) {
+		return err
+	}
+
+	loc, ok := l.locations[e.Category]
+	if !ok {
+		return err
+	}
+	if e.Host != "" {
+		line := loc.file.line(loc.line, "", e.Host)
+		// point at the second listing of a duplicate host
+		if line != 0 && errors.Is(e.Err, errDuplicateHost) {
+			if second := loc.file.line(line+1, "", e.Host); second != 0 {
+				line = second
+			}
+		}
+		if line != 0 {
+			loc.line = line
+		}
+	}
+	if pos := loc.String(); pos != "" {
+		return fmt.Errorf("%s: %w", pos, err)
+	}
+	return err
+}
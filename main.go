@@ -1,38 +1,54 @@
 package main
 
 import (
+	"errors"
 	"fmt"
 	"io/fs"
 	"log"
 	"net/http"
 	"os"
-	"runtime"
 	"strings"
 	"time"
 
 	"github.com/didip/tollbooth/v6/limiter"
 	"github.com/gorilla/handlers"
-	"github.com/kelseyhightower/envconfig"
 	"github.com/korylprince/ipscan/ping"
-	"github.com/korylprince/ipscan/resolve"
 )
 
+// checkScrapeAuth returns an error if auth isn't a valid <prefix>AUTH setting, or token isn't set for token auth
+func checkScrapeAuth(prefix, auth, token string) error {
+	switch auth {
+	case "basic", "none":
+	case "token":
+		if token == "" {
+			return fmt.Errorf("%sTOKEN must be set if %sAUTH is token", prefix, prefix)
+		}
+	default:
+		return fmt.Errorf("unknown %sAUTH %q: must be one of basic, token, none", prefix, auth)
+	}
+	return nil
+}
+
 // RunServer starts the server
 func RunServer() error {
-	config := new(Config)
-	err := envconfig.Process("", config)
+	config, err := LoadConfig()
 	if err != nil {
-		return fmt.Errorf("could not process configuration from environment: %w", err)
+		return err
 	}
-
-	if config.Resolvers == 0 {
-		config.Resolvers = runtime.NumCPU() * 4
+	if config.HostsPath == "" {
+		return errors.New("HOSTSPATH must be set")
 	}
-	if config.Pingers == 0 {
-		config.Pingers = runtime.NumCPU() * 2
+	if config.Password == "" {
+		return errors.New("PASSWORD must be set")
+	}
+	if err := checkScrapeAuth("METRICS", config.MetricsAuth, config.MetricsToken); err != nil {
+		return err
+	}
+	if err := checkScrapeAuth("PROBE", config.ProbeAuth, config.ProbeToken); err != nil {
+		return err
 	}
 
-	resolver := resolve.NewService(config.Resolvers, config.QueueSize)
+	resolver := NewResolver(config.Resolvers, config.QueueSize)
 
 	pinger, ips, err := ping.NewService(config.Pingers, config.QueueSize, config.Timeout, nil)
 	if err != nil {
@@ -45,11 +61,59 @@ func RunServer() error {
 	}
 	log.Println("Listening for ICMP on:", strings.Join(is, ", "))
 
-	svc, err := NewService(config, resolver, pinger)
+	pinger6, err := NewPing6Service(config.Timeout)
+	if err != nil {
+		log.Println("could not start ICMPv6 ping service, IPv6 addresses will not be pinged:", err)
+	} else {
+		log.Println("Listening for ICMPv6 on:", pinger6.conn.LocalAddr())
+	}
+
+	svc, err := NewService(config, resolver, pinger, pinger6)
 	if err != nil {
 		return fmt.Errorf("could not start service: %w", err)
 	}
 
+	if config.HistoryPath != "" {
+		svc.History, err = NewHistory(config.HistoryPath, config.HistoryRetention, config.HistoryRawRetention, config.HistoryResolution)
+		if err != nil {
+			return fmt.Errorf("could not open history: %w", err)
+		}
+		go svc.History.Run()
+	}
+
+	if config.AlertsPath != "" {
+		alertConfig, err := LoadAlertConfig(config.AlertsPath)
+		if err != nil {
+			return fmt.Errorf("could not load alerts: %w", err)
+		}
+		svc.Alerter = NewAlerter(alertConfig)
+	}
+
+	svc.Maintenance, err = NewMaintenance(config.MaintenancePath)
+	if err != nil {
+		return fmt.Errorf("could not load maintenance windows: %w", err)
+	}
+
+	svc.Acks, err = NewAcks(config.AcksPath)
+	if err != nil {
+		return fmt.Errorf("could not load acks: %w", err)
+	}
+	svc.State.SetAcks(svc.Acks.Message())
+
+	svc.Modules, err = LoadProbeModules(config.ModulesPath)
+	if err != nil {
+		return fmt.Errorf("could not load probe modules: %w", err)
+	}
+
+	schema, paths, err := LoadSchema(config.HostsPath, config.MaxExpand)
+	if err != nil {
+		return fmt.Errorf("could not load schema: %w", err)
+	}
+	svc.State.SetSchema(schema)
+
+	go svc.Monitor()
+	go svc.Watch(paths)
+
 	mux := http.NewServeMux()
 
 	distFS, _ := fs.Sub(dist, "ui/dist")
@@ -66,6 +130,37 @@ func RunServer() error {
 
 	mux.Handle("/schema", LimitHandler(lmt, svc.RequireAuth(svc.HandleSchema())))
 
+	// failed attempts are limited separately from logins, so clients that authenticate successfully aren't limited
+	failures := NewFailureLimiter(config.AuthRateLimit, []string{"RemoteAddr"})
+
+	// metrics and probes are scraped often, so only failed attempts are rate limited
+	scrapeAuth := func(auth, token string, next http.Handler) http.Handler {
+		switch auth {
+		case "basic":
+			return LimitFailures(failures, svc.RequireBasicAuth(next))
+		case "token":
+			return LimitFailures(failures, svc.RequireBearerToken(token, next))
+		}
+		return next
+	}
+	mux.Handle("/metrics", scrapeAuth(config.MetricsAuth, config.MetricsToken, svc.HandleMetrics()))
+	mux.Handle("/probe", scrapeAuth(config.ProbeAuth, config.ProbeToken, svc.HandleProbe()))
+
+	// API requests can use an existing session or basic authentication. Requests that change state require basic authentication,
+	// so other sites can't forge them with the session cookie
+	api := http.NewServeMux()
+	api.Handle("/api/v1/history", svc.HandleHistory())
+	api.Handle("/api/v1/states", svc.HandleStates())
+	api.Handle("/api/v1/categories", svc.HandleCategories())
+	api.Handle("/api/v1/hosts", svc.HandleHosts())
+	api.Handle("/api/v1/hosts/", svc.HandleHosts())
+	api.Handle("/api/v1/ips", svc.HandleIPs())
+	api.Handle("/api/v1/maintenance", svc.HandleMaintenance())
+	api.Handle("/api/v1/acks", svc.HandleAcks())
+	api.Handle("/api/v1/silences", svc.HandleSilences())
+	apiBasic := LimitFailures(failures, svc.RequireBasicAuth(api))
+	mux.Handle("/api/", svc.RequireCookieAuth(ReadOnlyHandler(api, apiBasic), apiBasic))
+
 	var handler = LogHandler(NewLogger(os.Stdout), handlers.CompressHandler(mux))
 
 	// rewrite for x-forwarded-for, etc headers
@@ -79,6 +174,23 @@ func RunServer() error {
 }
 
 func main() {
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "validate":
+			os.Exit(RunValidate(os.Args[2:]))
+		case "scan":
+			os.Exit(RunScan(os.Args[2:]))
+		case "check":
+			os.Exit(RunCheck(os.Args[2:]))
+		case "-h", "-help", "--help", "help":
+			fmt.Fprintf(os.Stderr, "Usage: %s [command]\n\nWith no command, the server is started. Commands:\n", os.Args[0])
+			fmt.Fprintln(os.Stderr, "  validate  check the hosts file for errors")
+			fmt.Fprintln(os.Stderr, "  scan      scan every host once and print the results")
+			fmt.Fprintln(os.Stderr, "  check     check a category or host as a Nagios plugin")
+			os.Exit(2)
+		}
+	}
+
 	if err := RunServer(); err != nil {
 		log.Println("could not start server:", err)
 	}
@@ -0,0 +1,366 @@
+package main
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"math/bits"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"gopkg.in/yaml.v2"
+)
+
+// cronFields are the minimum and maximum values of each cron field: minute, hour, day of month, month and day of week
+var cronFields = [5][2]int{{0, 59}, {0, 23}, {1, 31}, {1, 12}, {0, 7}}
+
+// Schedule is a parsed cron expression with the fields minute, hour, day of month, month and day of week.
+// Fields support *, values, ranges (a-b), steps (*/n, a-b/n, a/n for a to the maximum) and lists (a,b). Day of week 0 and 7 are Sunday
+type Schedule struct {
+	fields [5]uint64
+	// if both day fields are restricted, a time matches if either matches
+	domStar bool
+	dowStar bool
+}
+
+// ParseSchedule parses a cron expression
+func ParseSchedule(expr string) (*Schedule, error) {
+	parts := strings.Fields(expr)
+	if len(parts) != 5 {
+		return nil, fmt.Errorf("invalid schedule %q: expected 5 fields", expr)
+	}
+
+	s := &Schedule{domStar: parts[2] == "*", dowStar: parts[4] == "*"}
+	for idx, part := range parts {
+		bits, err := parseCronField(part, cronFields[idx][0], cronFields[idx][1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid schedule %q: %w", expr, err)
+		}
+		s.fields[idx] = bits
+	}
+
+	// 7 is an alias for Sunday
+	if s.fields[4]&(1<<7) != 0 {
+		s.fields[4] |= 1
+	}
+
+	return s, nil
+}
+
+func parseCronField(field string, min, max int) (uint64, error) {
+	var bits uint64
+	for _, item := range strings.Split(field, ",") {
+		step, stepped := 1, false
+		if idx := strings.Index(item, "/"); idx != -1 {
+			n, err := strconv.Atoi(item[idx+1:])
+			if err != nil || n < 1 {
+				return 0, fmt.Errorf("invalid step in %q", item)
+			}
+			step, stepped = n, true
+			item = item[:idx]
+		}
+
+		lo, hi := min, max
+		switch {
+		case item == "*":
+		case strings.Contains(item, "-"):
+			bounds := strings.SplitN(item, "-", 2)
+			var err1, err2 error
+			lo, err1 = strconv.Atoi(bounds[0])
+			hi, err2 = strconv.Atoi(bounds[1])
+			if err1 != nil || err2 != nil {
+				return 0, fmt.Errorf("invalid range %q", item)
+			}
+		default:
+			n, err := strconv.Atoi(item)
+			if err != nil {
+				return 0, fmt.Errorf("invalid value %q", item)
+			}
+			lo, hi = n, n
+			// a/n steps from a to the maximum
+			if stepped {
+				hi = max
+			}
+		}
+
+		if lo < min || hi > max || lo > hi {
+			return 0, fmt.Errorf("%q out of range %d-%d", item, min, max)
+		}
+		for n := lo; n <= hi; n += step {
+			bits |= 1 << uint(n)
+		}
+	}
+	return bits, nil
+}
+
+func (s *Schedule) has(idx, n int) bool {
+	return s.fields[idx]&(1<<uint(n)) != 0
+}
+
+// matchesDay returns true if the day containing t matches the schedule
+func (s *Schedule) matchesDay(t time.Time) bool {
+	if !s.has(3, int(t.Month())) {
+		return false
+	}
+
+	dom, dow := s.has(2, t.Day()), s.has(4, int(t.Weekday()))
+	if !s.domStar && !s.dowStar {
+		return dom || dow
+	}
+	return dom && dow
+}
+
+// latest returns the highest value at or below n in the field at idx, or -1 if there isn't one
+func (s *Schedule) latest(idx, n int) int {
+	return bits.Len64(s.fields[idx]&(1<<uint(n+1)-1)) - 1
+}
+
+// Matches returns true if the minute containing t matches the schedule
+func (s *Schedule) Matches(t time.Time) bool {
+	return s.has(0, t.Minute()) && s.has(1, t.Hour()) && s.matchesDay(t)
+}
+
+// Last returns the start of the latest minute matching the schedule at or before t, if it's less than within before t
+func (s *Schedule) Last(t time.Time, within time.Duration) (time.Time, bool) {
+	// step back over days, hours and minutes that don't match. Steps are taken from the wall clock so daylight saving time is handled
+	for m := t.Truncate(time.Minute); t.Sub(m) < within; {
+		switch {
+		case !s.matchesDay(m):
+			year, month, day := m.Date()
+			m = time.Date(year, month, day, 0, 0, 0, 0, m.Location()).Add(-time.Minute)
+		case !s.has(1, m.Hour()) || s.latest(0, m.Minute()) == -1:
+			m = m.Add(-time.Duration(m.Minute()+1) * time.Minute)
+		default:
+			m = m.Add(-time.Duration(m.Minute()-s.latest(0, m.Minute())) * time.Minute)
+			// the minute might not exist if the offset changed within the hour; stepping continues from wherever m is
+			if s.Matches(m) && t.Sub(m) < within {
+				return m, true
+			}
+		}
+	}
+	return time.Time{}, false
+}
+
+// MaintenanceWindow is a period of time hosts are in maintenance. If Hosts and Categories are both empty, the window applies to all hosts.
+// A window is either one-off, from Start to End, or recurring, starting at every time matching Schedule (in the server's time zone) and lasting Duration
+type MaintenanceWindow struct {
+	ID         string     `json:"id" yaml:"id"`
+	Comment    string     `json:"comment,omitempty" yaml:"comment,omitempty"`
+	Hosts      []string   `json:"hosts,omitempty" yaml:"hosts,omitempty"`
+	Categories []string   `json:"categories,omitempty" yaml:"categories,omitempty"`
+	Start      *time.Time `json:"start,omitempty" yaml:"start,omitempty"`
+	End        *time.Time `json:"end,omitempty" yaml:"end,omitempty"`
+	Schedule   string     `json:"schedule,omitempty" yaml:"schedule,omitempty"`
+	Duration   string     `json:"duration,omitempty" yaml:"duration,omitempty"`
+
+	schedule *Schedule
+	duration time.Duration
+}
+
+// Validate returns an error if the window is invalid
+func (w *MaintenanceWindow) Validate() error {
+	if w.Schedule == "" {
+		if w.Start == nil || w.End == nil {
+			return errors.New("start and end, or schedule and duration, must be set")
+		}
+		if !w.End.After(*w.Start) {
+			return errors.New("end must be after start")
+		}
+		return nil
+	}
+
+	if w.Start != nil || w.End != nil {
+		return errors.New("start and end can't be used with schedule")
+	}
+	schedule, err := ParseSchedule(w.Schedule)
+	if err != nil {
+		return err
+	}
+	duration, err := time.ParseDuration(w.Duration)
+	if err != nil {
+		return fmt.Errorf("invalid duration %q: %w", w.Duration, err)
+	}
+	if duration < time.Minute {
+		return errors.New("duration must be at least 1m")
+	}
+	w.schedule, w.duration = schedule, duration
+
+	return nil
+}
+
+// Active returns true if the window is active at t
+func (w *MaintenanceWindow) Active(t time.Time) bool {
+	if w.schedule == nil {
+		return !t.Before(*w.Start) && t.Before(*w.End)
+	}
+
+	_, ok := w.schedule.Last(t, w.duration)
+	return ok
+}
+
+// Covers returns true if the window applies to host, which is in categories
+func (w *MaintenanceWindow) Covers(host string, categories []string) bool {
+	if len(w.Hosts) == 0 && len(w.Categories) == 0 {
+		return true
+	}
+	for _, h := range w.Hosts {
+		if h == host {
+			return true
+		}
+	}
+	for _, c := range w.Categories {
+		for _, hc := range categories {
+			if c == hc {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Maintenance stores maintenance windows. If path isn't empty, windows are loaded from and saved to the yaml file at path
+type Maintenance struct {
+	path    string
+	windows []*MaintenanceWindow
+	mu      *sync.RWMutex
+}
+
+// NewMaintenance returns a new *Maintenance with the windows in the file at path, if it exists
+func NewMaintenance(path string) (*Maintenance, error) {
+	m := &Maintenance{path: path, windows: make([]*MaintenanceWindow, 0), mu: new(sync.RWMutex)}
+	if path == "" {
+		return m, nil
+	}
+
+	buf, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return m, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("could not read maintenance file: %w", err)
+	}
+
+	if err = yaml.NewDecoder(bytes.NewBuffer(buf)).Decode(&m.windows); err != nil && err != io.EOF {
+		return nil, fmt.Errorf("could not parse maintenance file: %w", err)
+	}
+
+	ids := make(map[string]struct{})
+	for idx, w := range m.windows {
+		if w.ID == "" {
+			if w.ID, err = newID(); err != nil {
+				return nil, err
+			}
+		}
+		if _, ok := ids[w.ID]; ok {
+			return nil, fmt.Errorf("duplicate maintenance window id %q", w.ID)
+		}
+		ids[w.ID] = struct{}{}
+		if err = w.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid maintenance window %d: %w", idx, err)
+		}
+	}
+
+	return m, nil
+}
+
+// newID returns a random ID for a maintenance window or silence
+func newID() (string, error) {
+	buf := make([]byte, 8)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("could not generate id: %w", err)
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// save writes the windows to the file. The caller must hold a lock
+func (m *Maintenance) save() error {
+	if m.path == "" {
+		return nil
+	}
+
+	buf, err := yaml.Marshal(m.windows)
+	if err != nil {
+		return fmt.Errorf("could not marshal maintenance windows: %w", err)
+	}
+
+	tmp := m.path + ".tmp"
+	if err = os.WriteFile(tmp, buf, 0644); err != nil {
+		return fmt.Errorf("could not write maintenance file: %w", err)
+	}
+	if err = os.Rename(tmp, m.path); err != nil {
+		return fmt.Errorf("could not rename maintenance file: %w", err)
+	}
+
+	return nil
+}
+
+// Windows returns all windows
+func (m *Maintenance) Windows() []*MaintenanceWindow {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return append(make([]*MaintenanceWindow, 0, len(m.windows)), m.windows...)
+}
+
+// Add adds w with a new ID, and saves the windows. w must be validated first
+func (m *Maintenance) Add(w *MaintenanceWindow) error {
+	id, err := newID()
+	if err != nil {
+		return err
+	}
+	w.ID = id
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.windows = append(m.windows, w)
+	if err := m.save(); err != nil {
+		m.windows = m.windows[:len(m.windows)-1]
+		return err
+	}
+	return nil
+}
+
+// ErrWindowNotFound is returned when removing a window that doesn't exist
+var ErrWindowNotFound = errors.New("maintenance window not found")
+
+// Remove removes the window with the given id, and saves the windows
+func (m *Maintenance) Remove(id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for idx, w := range m.windows {
+		if w.ID != id {
+			continue
+		}
+		windows := append(append(make([]*MaintenanceWindow, 0, len(m.windows)-1), m.windows[:idx]...), m.windows[idx+1:]...)
+		old := m.windows
+		m.windows = windows
+		if err := m.save(); err != nil {
+			m.windows = old
+			return err
+		}
+		return nil
+	}
+
+	return ErrWindowNotFound
+}
+
+// Active returns the windows active at t
+func (m *Maintenance) Active(t time.Time) []*MaintenanceWindow {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	active := make([]*MaintenanceWindow, 0)
+	for _, w := range m.windows {
+		if w.Active(t) {
+			active = append(active, w)
+		}
+	}
+	return active
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseSchedule(t *testing.T) {
+	tests := []struct {
+		expr    string
+		minutes []int
+		valid   bool
+	}{
+		{expr: "*/15 * * * *", minutes: []int{0, 15, 30, 45}, valid: true},
+		{expr: "5/15 * * * *", minutes: []int{5, 20, 35, 50}, valid: true},
+		{expr: "10-30/10 * * * *", minutes: []int{10, 20, 30}, valid: true},
+		{expr: "1,2,40-41 * * * *", minutes: []int{1, 2, 40, 41}, valid: true},
+		{expr: "* * * *"},
+		{expr: "60 * * * *"},
+		{expr: "5/0 * * * *"},
+		{expr: "30-10 * * * *"},
+		{expr: "* * 0 * *"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.expr, func(t *testing.T) {
+			s, err := ParseSchedule(test.expr)
+			if !test.valid {
+				if err == nil {
+					t.Error("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var want uint64
+			for _, m := range test.minutes {
+				want |= 1 << uint(m)
+			}
+			if s.fields[0] != want {
+				t.Errorf("minutes = %b, want %b", s.fields[0], want)
+			}
+		})
+	}
+}
+
+func TestMaintenanceWindowActive(t *testing.T) {
+	chicago, err := time.LoadLocation("America/Chicago")
+	if err != nil {
+		t.Skipf("could not load time zone: %v", err)
+	}
+	at := func(s string) time.Time {
+		tm, err := time.ParseInLocation("2006-01-02 15:04", s, chicago)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return tm
+	}
+
+	tests := []struct {
+		name     string
+		schedule string
+		duration string
+		time     time.Time
+		active   bool
+	}{
+		{name: "at start", schedule: "0 22 * * 2", duration: "4h", time: at("2024-05-07 22:00"), active: true},
+		{name: "before start", schedule: "0 22 * * 2", duration: "4h", time: at("2024-05-07 21:59"), active: false},
+		{name: "past midnight", schedule: "0 22 * * 2", duration: "4h", time: at("2024-05-08 01:59"), active: true},
+		{name: "at end", schedule: "0 22 * * 2", duration: "4h", time: at("2024-05-08 02:00"), active: false},
+		{name: "wrong day", schedule: "0 22 * * 2", duration: "4h", time: at("2024-05-08 22:30"), active: false},
+		{name: "long window", schedule: "0 0 1 * *", duration: "168h", time: at("2024-05-07 23:59"), active: true},
+		{name: "long window ended", schedule: "0 0 1 * *", duration: "168h", time: at("2024-05-08 00:00"), active: false},
+		{name: "step", schedule: "5/15 * * * *", duration: "5m", time: at("2024-05-07 10:24"), active: true},
+		{name: "step gap", schedule: "5/15 * * * *", duration: "5m", time: at("2024-05-07 10:25"), active: false},
+		{name: "start skipped by dst", schedule: "30 2 * * *", duration: "2h", time: at("2024-03-10 03:45"), active: false},
+		{name: "duration is elapsed time across dst", schedule: "0 1 * * *", duration: "2h", time: at("2024-03-10 03:59"), active: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := &MaintenanceWindow{Schedule: test.schedule, Duration: test.duration}
+			if err := w.Validate(); err != nil {
+				t.Fatalf("could not validate window: %v", err)
+			}
+			if active := w.Active(test.time); active != test.active {
+				t.Errorf("active = %v, want %v", active, test.active)
+			}
+		})
+	}
+}
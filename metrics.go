@@ -0,0 +1,170 @@
+package main
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
+)
+
+// counters are process metrics of a Service. They're updated atomically.
+// Pings and resolves are counted from when a scan starts them until they return, which includes time spent in the
+// ping and resolve services' queues
+type counters struct {
+	websockets       int64
+	pingsInFlight    int64
+	resolvesInFlight int64
+	authFailures     int64
+}
+
+// metricFamily is a metric in the Prometheus text exposition format
+type metricFamily struct {
+	name    string
+	help    string
+	typ     string
+	samples []string
+}
+
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// add adds a sample with value and labels, given as name, value pairs
+func (f *metricFamily) add(value float64, labels ...string) {
+	pairs := make([]string, 0, len(labels)/2)
+	for idx := 0; idx+1 < len(labels); idx += 2 {
+		pairs = append(pairs, fmt.Sprintf(`%s="%s"`, labels[idx], labelEscaper.Replace(labels[idx+1])))
+	}
+	sample := f.name
+	if len(pairs) > 0 {
+		sample += "{" + strings.Join(pairs, ",") + "}"
+	}
+	f.samples = append(f.samples, sample+" "+strconv.FormatFloat(value, 'g', -1, 64))
+}
+
+// write writes f to w. Families without samples aren't written
+func (f *metricFamily) write(w io.Writer) error {
+	if len(f.samples) == 0 {
+		return nil
+	}
+	_, err := fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n%s\n", f.name, f.help, f.name, f.typ, strings.Join(f.samples, "\n"))
+	return err
+}
+
+// boolValue returns 1 if b is true, or 0
+func boolValue(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// metrics returns the per host and per IP metrics of every host in the schema
+func (s *State) metrics() []*metricFamily {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	hostUp := &metricFamily{name: "ping_dashboard_host_up", typ: "gauge", help: "Whether the host is up (1) or down (0). Degraded hosts are up and hosts with an unknown state are omitted"}
+	dnsSuccess := &metricFamily{name: "ping_dashboard_dns_success", typ: "gauge", help: "Whether the host's latest DNS resolution succeeded"}
+	dnsDuration := &metricFamily{name: "ping_dashboard_dns_duration_seconds", typ: "gauge", help: "Duration of the host's latest DNS resolution"}
+	ipUp := &metricFamily{name: "ping_dashboard_ip_up", typ: "gauge", help: "Whether the IP responded to any echo request in the latest scan"}
+	ipRTT := &metricFamily{name: "ping_dashboard_ip_rtt_seconds", typ: "gauge", help: "Average round trip time of the IP's echo replies in the latest scan"}
+	ipLoss := &metricFamily{name: "ping_dashboard_ip_loss_ratio", typ: "gauge", help: "Ratio of echo requests to the IP that were lost in the latest scan"}
+
+	categories := s.hostCategories()
+	seen := make(map[string]struct{})
+	for _, c := range s.schema.Categories() {
+		for _, h := range c.Hosts {
+			if _, ok := seen[h.Host]; ok {
+				continue
+			}
+			seen[h.Host] = struct{}{}
+
+			// hosts are labeled with the category they're directly in
+			category := ""
+			if cs := categories[h.Host]; len(cs) > 0 {
+				category = cs[0]
+			}
+
+			if hs, ok := s.hostStates[h.Host]; ok && hs.Status != StatusUnknown {
+				hostUp.add(boolValue(!isDown(hs.Status)), "host", h.Host, "category", category)
+			}
+
+			r, ok := s.resolves[h.Host]
+			if !ok {
+				continue
+			}
+			dnsSuccess.add(boolValue(r.Error == nil), "host", h.Host)
+			dnsDuration.add(r.Duration.Seconds(), "host", h.Host)
+
+			if !*h.ICMP {
+				continue
+			}
+			for _, ip := range r.IPs {
+				st, ok := s.stats[ip.String()]
+				if !ok || st.Sent == 0 {
+					continue
+				}
+				labels := []string{"host", h.Host, "ip", ip.String(), "family", string(IPFamily(ip))}
+				ipUp.add(boolValue(st.Received > 0), labels...)
+				ipLoss.add(st.Loss()/100, labels...)
+				if st.Received > 0 {
+					ipRTT.add(st.Avg.Seconds(), labels...)
+				}
+			}
+		}
+	}
+
+	return []*metricFamily{hostUp, dnsSuccess, dnsDuration, ipUp, ipRTT, ipLoss}
+}
+
+// metrics returns the process metrics in c, and the queue depth of resolver
+func (c *counters) metrics(resolver *Resolver) []*metricFamily {
+	websockets := &metricFamily{name: "ping_dashboard_websockets_active", typ: "gauge", help: "Number of connected websocket clients"}
+	websockets.add(float64(atomic.LoadInt64(&c.websockets)))
+	pings := &metricFamily{name: "ping_dashboard_pings_in_flight", typ: "gauge", help: "Number of echo requests started by scans that haven't completed"}
+	pings.add(float64(atomic.LoadInt64(&c.pingsInFlight)))
+	resolves := &metricFamily{name: "ping_dashboard_resolves_in_flight", typ: "gauge", help: "Number of DNS resolutions started by scans that haven't completed"}
+	resolves.add(float64(atomic.LoadInt64(&c.resolvesInFlight)))
+	queued := &metricFamily{name: "ping_dashboard_aaaa_lookups_queued", typ: "gauge", help: "Number of AAAA lookups waiting for a resolver worker"}
+	queued.add(float64(resolver.Queued6()))
+	failures := &metricFamily{name: "ping_dashboard_auth_failures_total", typ: "counter", help: "Number of requests with invalid credentials"}
+	failures.add(float64(atomic.LoadInt64(&c.authFailures)))
+	return []*metricFamily{websockets, pings, resolves, queued, failures}
+}
+
+// HandleMetrics returns an http.Handler that serves metrics in the Prometheus text exposition format
+func (s *Service) HandleMetrics() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		l := r.Context().Value(ContextKeyLog).(*Log)
+
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		for _, f := range append(s.counters.metrics(s.Resolver), s.State.metrics()...) {
+			if err := f.write(w); err != nil {
+				l.Error = &Error{fmt.Errorf("could not write metrics: %w", err)}
+				return
+			}
+		}
+	})
+}
+
+// RequireBearerToken is an HTTP middleware that verifies the request has an Authorization header with token as its bearer token
+func (s *Service) RequireBearerToken(token string, next http.Handler) http.Handler {
+	expected := []byte("Bearer " + token)
+	expectedLen := int32(len(expected))
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth := []byte(r.Header.Get("Authorization"))
+		if subtle.ConstantTimeEq(expectedLen, int32(len(auth))) != 1 ||
+			subtle.ConstantTimeCompare(expected, auth) != 1 {
+			if len(auth) > 0 {
+				atomic.AddInt64(&s.counters.authFailures, 1)
+			}
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
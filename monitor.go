@@ -0,0 +1,368 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"reflect"
+	"sync"
+	"time"
+)
+
+// State holds the latest schema and probe results and broadcasts changes to subscribers
+type State struct {
+	schema     Schema
+	resolves   map[string]*Resolve
+	pings      map[string]*Ping
+	stats      map[string]*Stats
+	tcps       map[string]*TCP
+	https      map[string]*HTTP
+	tlss       map[string]*TLS
+	hostStates map[string]*HostState
+	aggregates Aggregates
+	acks       json.Marshaler
+	// schemaErr is the error from the last attempt to reload the schema, if any
+	schemaErr error
+	subs      map[chan json.Marshaler]struct{}
+	bufSize   int
+	mu        *sync.RWMutex
+}
+
+// NewState returns a new State. bufSize is the amount of messages a subscriber can fall behind before being dropped
+func NewState(bufSize int) *State {
+	return &State{
+		schema:     make(Schema, 0),
+		resolves:   make(map[string]*Resolve),
+		pings:      make(map[string]*Ping),
+		stats:      make(map[string]*Stats),
+		tcps:       make(map[string]*TCP),
+		https:      make(map[string]*HTTP),
+		tlss:       make(map[string]*TLS),
+		hostStates: make(map[string]*HostState),
+		subs:       make(map[chan json.Marshaler]struct{}),
+		bufSize:    bufSize,
+		mu:         new(sync.RWMutex),
+	}
+}
+
+// broadcast sends msg to all subscribers. Subscribers that can't keep up are closed and removed.
+// The caller must hold the write lock
+func (s *State) broadcast(msg json.Marshaler) {
+	for ch := range s.subs {
+		select {
+		case ch <- msg:
+		default:
+			close(ch)
+			delete(s.subs, ch)
+		}
+	}
+}
+
+// SetAcks replaces the current acks and silences message and broadcasts it
+func (s *State) SetAcks(msg json.Marshaler) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.acks = msg
+	s.broadcast(msg)
+}
+
+// Schema returns the current schema
+func (s *State) Schema() Schema {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.schema
+}
+
+// SetSchema replaces the current schema, removes results for hosts no longer in the schema, and broadcasts the changes if it changed.
+// It returns true if the schema changed
+func (s *State) SetSchema(schema Schema) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if reflect.DeepEqual(s.schema, schema) {
+		return false
+	}
+	old := s.schema
+	s.schema = schema
+
+	hosts := make(map[string]struct{})
+	for _, hs := range schema.Categories() {
+		for _, h := range hs.Hosts {
+			hosts[h.Host] = struct{}{}
+		}
+	}
+	for h := range s.resolves {
+		if _, ok := hosts[h]; !ok {
+			delete(s.resolves, h)
+		}
+	}
+	s.prune()
+
+	// clients that already have a schema only need the changes
+	if diff, ok := DiffSchema(old, schema); ok && len(old) > 0 {
+		s.broadcast(diff)
+	} else {
+		s.broadcast(schema)
+	}
+	s.updateAggregates()
+
+	return true
+}
+
+// SetSchemaError sets the error from the last attempt to reload the schema, or clears it if err is nil, and broadcasts it if it changed
+func (s *State) SetSchemaError(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if (err == nil && s.schemaErr == nil) || (err != nil && s.schemaErr != nil && err.Error() == s.schemaErr.Error()) {
+		return
+	}
+	s.schemaErr = err
+	s.broadcast(&SchemaError{Error: err})
+}
+
+// Prune removes results for IPs and ports that are no longer probed
+func (s *State) Prune() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.prune()
+}
+
+func (s *State) prune() {
+	ips := make(map[string]struct{})
+	tcps := make(map[string]struct{})
+	https := make(map[string]struct{})
+	tlss := make(map[string]struct{})
+	for _, c := range s.schema.Categories() {
+		for _, h := range c.Hosts {
+			for _, check := range h.HTTP {
+				https[httpKey(h.Host, check.ExpandURL(h.Host))] = struct{}{}
+			}
+			for _, check := range h.TLS {
+				tlss[check.newTLS(h.Host).Key()] = struct{}{}
+			}
+
+			r, ok := s.resolves[h.Host]
+			if !ok {
+				continue
+			}
+			for _, ip := range r.IPs {
+				ips[ip.String()] = struct{}{}
+				for _, port := range h.TCP {
+					tcps[(&TCP{IP: ip, Port: port}).Addr()] = struct{}{}
+				}
+			}
+		}
+	}
+
+	for ip := range s.pings {
+		if _, ok := ips[ip]; !ok {
+			delete(s.pings, ip)
+		}
+	}
+	for ip := range s.stats {
+		if _, ok := ips[ip]; !ok {
+			delete(s.stats, ip)
+		}
+	}
+	for addr := range s.tcps {
+		if _, ok := tcps[addr]; !ok {
+			delete(s.tcps, addr)
+		}
+	}
+	for key := range s.https {
+		if _, ok := https[key]; !ok {
+			delete(s.https, key)
+		}
+	}
+	for key := range s.tlss {
+		if _, ok := tlss[key]; !ok {
+			delete(s.tlss, key)
+		}
+	}
+}
+
+// httpKey returns the key used to store the result of an HTTP check of url for hostname
+func httpKey(hostname, url string) string {
+	return hostname + " " + url
+}
+
+// Update stores the given probe result and broadcasts it
+func (s *State) Update(msg json.Marshaler) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch m := msg.(type) {
+	case *Resolve:
+		s.resolves[m.Hostname] = m
+	case *Ping:
+		s.pings[m.IP.String()] = m
+	case *Stats:
+		s.stats[m.IP.String()] = m
+	case *TCP:
+		s.tcps[m.Addr()] = m
+	case *HTTP:
+		s.https[httpKey(m.Hostname, m.URL)] = m
+	case *TLS:
+		s.tlss[m.Key()] = m
+	default:
+		return fmt.Errorf("unknown message type: %T", msg)
+	}
+
+	s.broadcast(msg)
+	return nil
+}
+
+// snapshot returns the messages needed to bring a new subscriber up to date. The caller must hold a lock
+func (s *State) snapshot() []json.Marshaler {
+	msgs := []json.Marshaler{s.schema}
+	if s.aggregates != nil {
+		msgs = append(msgs, s.aggregates)
+	}
+	if s.acks != nil {
+		msgs = append(msgs, s.acks)
+	}
+	if s.schemaErr != nil {
+		msgs = append(msgs, &SchemaError{Error: s.schemaErr})
+	}
+	seen := make(map[string]struct{})
+	for _, hs := range s.schema.Categories() {
+		for _, h := range hs.Hosts {
+			if _, ok := seen[h.Host]; ok {
+				continue
+			}
+			seen[h.Host] = struct{}{}
+			for _, check := range h.HTTP {
+				if ht, ok := s.https[httpKey(h.Host, check.ExpandURL(h.Host))]; ok {
+					msgs = append(msgs, ht)
+				}
+			}
+			for _, check := range h.TLS {
+				if t, ok := s.tlss[check.newTLS(h.Host).Key()]; ok {
+					msgs = append(msgs, t)
+				}
+			}
+			if hs, ok := s.hostStates[h.Host]; ok {
+				msgs = append(msgs, hs.Copy())
+			}
+			r, ok := s.resolves[h.Host]
+			if !ok {
+				continue
+			}
+			msgs = append(msgs, r)
+			for _, ip := range r.IPs {
+				if p, ok := s.pings[ip.String()]; ok {
+					msgs = append(msgs, p)
+				}
+				if st, ok := s.stats[ip.String()]; ok {
+					msgs = append(msgs, st)
+				}
+				for _, port := range h.TCP {
+					if t, ok := s.tcps[(&TCP{IP: ip, Port: port}).Addr()]; ok {
+						msgs = append(msgs, t)
+					}
+				}
+			}
+		}
+	}
+	return msgs
+}
+
+// Subscribe returns a snapshot of the current state and a channel that receives all future updates.
+// The channel is closed if the subscriber falls too far behind. Unsubscribe must be called when the subscriber is finished
+func (s *State) Subscribe() ([]json.Marshaler, chan json.Marshaler) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ch := make(chan json.Marshaler, s.bufSize)
+	s.subs[ch] = struct{}{}
+	return s.snapshot(), ch
+}
+
+// Unsubscribe removes the given subscriber
+func (s *State) Unsubscribe(ch chan json.Marshaler) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.subs[ch]; ok {
+		close(ch)
+		delete(s.subs, ch)
+	}
+}
+
+// record stores msg in s.State and s.History
+func (s *Service) record(msg json.Marshaler) error {
+	if err := s.State.Update(msg); err != nil {
+		return err
+	}
+	if s.History != nil {
+		if err := s.History.Record(msg); err != nil {
+			log.Println("could not record history:", err)
+		}
+	}
+	return nil
+}
+
+func (s *Service) stateConfig() *StateConfig {
+	return &StateConfig{
+		FailThreshold:    s.Config.FailThreshold,
+		RecoverThreshold: s.Config.RecoverThreshold,
+		FlapWindow:       s.Config.FlapWindow,
+		FlapThreshold:    s.Config.FlapThreshold,
+		InMaintenance:    s.inMaintenance(),
+	}
+}
+
+// inMaintenance returns a function that returns true if host, which is in categories, is in a currently active maintenance window
+func (s *Service) inMaintenance() func(host string, categories []string) bool {
+	if s.Maintenance == nil {
+		return nil
+	}
+
+	active := s.Maintenance.Active(time.Now())
+	return func(host string, categories []string) bool {
+		for _, w := range active {
+			if w.Covers(host, categories) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// updateHostStates updates host states with config, and updates acks and sends alerts for the transitions
+func (s *Service) updateHostStates(config *StateConfig) {
+	transitions := s.State.UpdateHostStates(config)
+	if s.Acks != nil {
+		changed, err := s.Acks.Update(transitions)
+		if err != nil {
+			log.Println("could not update acks:", err)
+		}
+		if changed {
+			s.State.SetAcks(s.Acks.Message())
+		}
+	}
+	if s.Alerter != nil {
+		s.Alerter.Notify(transitions)
+	}
+}
+
+// Monitor scans all hosts every Config.Interval, or as soon as the schema is reloaded, and stores the results in s.State. Monitor never returns
+func (s *Service) Monitor() {
+	t := time.NewTicker(s.Config.Interval)
+	defer t.Stop()
+
+	for {
+		if err := s.Scan(s.State.Schema(), s.record); err != nil {
+			log.Println("could not scan hosts:", err)
+		}
+		s.State.Prune()
+		s.updateHostStates(s.stateConfig())
+
+		select {
+		case <-t.C:
+		case <-s.rescan:
+		}
+	}
+}
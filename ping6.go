@@ -0,0 +1,117 @@
+package main
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/korylprince/ipscan/ping"
+)
+
+const (
+	icmpv6EchoRequest = 128
+	icmpv6EchoReply   = 129
+)
+
+// Ping6Service sends ICMPv6 echo requests concurrently. Its results use the same types as ping.Service
+type Ping6Service struct {
+	conn    *net.IPConn
+	timeout time.Duration
+
+	sequence uint16
+	pending  map[uint16]*ping6
+	mu       *sync.Mutex
+}
+
+type ping6 struct {
+	*ping.Ping
+	callback chan time.Time
+}
+
+// NewPing6Service returns a new *Ping6Service listening for ICMPv6 echo replies on all interfaces
+func NewPing6Service(timeout time.Duration) (*Ping6Service, error) {
+	conn, err := net.ListenIP("ip6:ipv6-icmp", &net.IPAddr{IP: net.IPv6unspecified})
+	if err != nil {
+		return nil, fmt.Errorf("could not start listener: %w", err)
+	}
+
+	s := &Ping6Service{
+		conn:    conn,
+		timeout: timeout,
+		pending: make(map[uint16]*ping6),
+		mu:      new(sync.Mutex),
+	}
+
+	go s.receiver()
+
+	return s, nil
+}
+
+func (s *Ping6Service) receiver() {
+	buf := make([]byte, 1500)
+	for {
+		n, raddr, err := s.conn.ReadFromIP(buf)
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return
+			}
+			continue
+		}
+		recv := time.Now()
+
+		// the kernel strips the IPv6 header from raw ICMPv6 sockets
+		if n < 8 || buf[0] != icmpv6EchoReply || buf[1] != 0 {
+			continue
+		}
+		if binary.BigEndian.Uint16(buf[4:6]) != ping.ICMPEchoRequestIdentifier {
+			continue
+		}
+		seq := binary.BigEndian.Uint16(buf[6:8])
+
+		s.mu.Lock()
+		if p, ok := s.pending[seq]; ok && p.IP.Equal(raddr.IP) {
+			p.callback <- recv
+			delete(s.pending, seq)
+		}
+		s.mu.Unlock()
+	}
+}
+
+// Ping sends one ICMPv6 echo request to ip and returns a *ping.Ping, or an error if one occurred
+func (s *Ping6Service) Ping(ip net.IP) (*ping.Ping, error) {
+	p := &ping6{Ping: &ping.Ping{IP: ip}, callback: make(chan time.Time, 1)}
+
+	s.mu.Lock()
+	s.sequence++
+	p.Sequence = s.sequence
+	s.pending[p.Sequence] = p
+	s.mu.Unlock()
+
+	// checksum is calculated by the kernel
+	packet := make([]byte, 8)
+	packet[0] = icmpv6EchoRequest
+	binary.BigEndian.PutUint16(packet[4:6], ping.ICMPEchoRequestIdentifier)
+	binary.BigEndian.PutUint16(packet[6:8], p.Sequence)
+
+	p.SentTime = time.Now()
+	if _, err := s.conn.WriteToIP(packet, &net.IPAddr{IP: ip}); err != nil {
+		s.mu.Lock()
+		delete(s.pending, p.Sequence)
+		s.mu.Unlock()
+		return p.Ping, fmt.Errorf("could not send echo request: %w", err)
+	}
+
+	select {
+	case recv := <-p.callback:
+		p.RecvTime = &recv
+	case <-time.After(s.timeout):
+		s.mu.Lock()
+		delete(s.pending, p.Sequence)
+		s.mu.Unlock()
+	}
+
+	return p.Ping, nil
+}
@@ -0,0 +1,211 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"time"
+
+	"gopkg.in/yaml.v2"
+)
+
+// defaultModule is the module used if a probe doesn't specify one. Unless it's configured, it only pings the target
+const defaultModule = "icmp"
+
+// ProbeModule configures the probes run against a target by /probe. Its options are the same as a category's
+type ProbeModule struct {
+	Timeout time.Duration `yaml:"timeout"`
+	Probes  []string      `yaml:"probes"`
+	Reverse *bool         `yaml:"reverse"`
+	Family  *Family       `yaml:"family"`
+	ICMP    *bool         `yaml:"icmp"`
+	TCP     []int         `yaml:"tcp"`
+	HTTP    []*HTTPCheck  `yaml:"http"`
+	TLS     []*TLSCheck   `yaml:"tls"`
+
+	// prepared has m's options applied and validated. It's shared by concurrent probes, so it must not be changed
+	prepared *Host
+}
+
+// validate applies m's options to a host and validates them. It's called once when the modules are loaded
+func (m *ProbeModule) validate() error {
+	c := &Category{
+		Category: "probe", Path: "probe",
+		Timeout: m.Timeout, Probes: m.Probes, Reverse: m.Reverse, Family: m.Family,
+		ICMP: m.ICMP, TCP: m.TCP, HTTP: m.HTTP, TLS: m.TLS,
+	}
+	h := new(Host)
+	if err := prepareHost(c, h); err != nil {
+		return err
+	}
+	m.prepared = h
+	return nil
+}
+
+// host returns a Host for target with m's options applied. The checks are already validated, so they're copied as is
+func (m *ProbeModule) host(target string) *Host {
+	h := *m.prepared
+	h.Host = target
+	h.TCP = append([]int(nil), m.prepared.TCP...)
+	h.HTTP = append([]*HTTPCheck(nil), m.prepared.HTTP...)
+	h.TLS = append([]*TLSCheck(nil), m.prepared.TLS...)
+	return &h
+}
+
+// ProbeConfig is the modules configuration
+type ProbeConfig struct {
+	Modules map[string]*ProbeModule `yaml:"modules"`
+}
+
+// LoadProbeModules returns the modules configured in the file at path. If path is empty, only the default module is returned
+func LoadProbeModules(path string) (map[string]*ProbeModule, error) {
+	config := new(ProbeConfig)
+	if path != "" {
+		buf, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("could not read modules file: %w", err)
+		}
+
+		dec := yaml.NewDecoder(bytes.NewBuffer(buf))
+		dec.SetStrict(true)
+		if err = dec.Decode(config); err != nil && err != io.EOF {
+			return nil, fmt.Errorf("could not parse modules file: %w", err)
+		}
+	}
+
+	if config.Modules == nil {
+		config.Modules = make(map[string]*ProbeModule)
+	}
+	if _, ok := config.Modules[defaultModule]; !ok {
+		config.Modules[defaultModule] = new(ProbeModule)
+	}
+
+	for name, m := range config.Modules {
+		// modules with no options are parsed as nil
+		if m == nil {
+			m = new(ProbeModule)
+			config.Modules[name] = m
+		}
+		if err := m.validate(); err != nil {
+			return nil, fmt.Errorf("invalid module %q: %w", name, err)
+		}
+	}
+
+	return config.Modules, nil
+}
+
+// Probe scans h once with a new State, so the results aren't shown on the dashboard, and returns its status
+func (s *Service) Probe(h *Host) (*HostStatus, error) {
+	state := NewState(s.Config.QueueSize)
+	if err := s.scanOnce(state, Schema{{Category: "probe", Path: "probe", Hosts: []*Host{h}}}); err != nil {
+		return nil, err
+	}
+	return state.HostStatuses()[0], nil
+}
+
+// probeMetrics returns the metrics of status, a probe that took duration
+func probeMetrics(status *HostStatus, duration time.Duration) []*metricFamily {
+	success := &metricFamily{name: "probe_success", typ: "gauge", help: "Whether the target is up or degraded"}
+	success.add(boolValue(status.State != StatusUnknown && !isDown(status.State)))
+	dur := &metricFamily{name: "probe_duration_seconds", typ: "gauge", help: "Duration of the probe"}
+	dur.add(duration.Seconds())
+	dnsSuccess := &metricFamily{name: "probe_dns_success", typ: "gauge", help: "Whether DNS resolution succeeded"}
+	dnsSuccess.add(boolValue(status.Error == ""))
+	dnsDuration := &metricFamily{name: "probe_dns_lookup_time_seconds", typ: "gauge", help: "Duration of DNS resolution"}
+	dnsDuration.add((time.Duration(status.ResolveLatency) * time.Microsecond).Seconds())
+
+	ipUp := &metricFamily{name: "probe_ip_up", typ: "gauge", help: "Whether the IP responded to any echo request"}
+	ipRTT := &metricFamily{name: "probe_ip_rtt_seconds", typ: "gauge", help: "Average round trip time of the IP's echo replies"}
+	ipLoss := &metricFamily{name: "probe_ip_loss_ratio", typ: "gauge", help: "Ratio of echo requests to the IP that were lost"}
+	tcpUp := &metricFamily{name: "probe_tcp_up", typ: "gauge", help: "Whether a TCP connection to the port succeeded"}
+	tcpDuration := &metricFamily{name: "probe_tcp_duration_seconds", typ: "gauge", help: "Duration of the TCP connection"}
+	for _, ip := range status.IPs {
+		labels := []string{"ip", ip.IP, "family", string(ip.Family)}
+		if ip.Sent > 0 {
+			ipUp.add(boolValue(ip.Received > 0), labels...)
+			ipLoss.add(ip.Loss/100, labels...)
+			if ip.Received > 0 {
+				ipRTT.add((time.Duration(ip.Latency) * time.Microsecond).Seconds(), labels...)
+			}
+		}
+		for _, t := range ip.TCP {
+			labels := []string{"ip", ip.IP, "port", strconv.Itoa(t.Port)}
+			tcpUp.add(boolValue(t.Up), labels...)
+			if t.Up {
+				tcpDuration.add((time.Duration(t.Latency) * time.Microsecond).Seconds(), labels...)
+			}
+		}
+	}
+
+	httpUp := &metricFamily{name: "probe_http_up", typ: "gauge", help: "Whether the HTTP check passed"}
+	httpStatus := &metricFamily{name: "probe_http_status_code", typ: "gauge", help: "HTTP response status code"}
+	httpDuration := &metricFamily{name: "probe_http_duration_seconds", typ: "gauge", help: "Duration of the HTTP request"}
+	for _, h := range status.HTTP {
+		httpUp.add(boolValue(h.Up), "url", h.URL)
+		if h.Status != 0 {
+			httpStatus.add(float64(h.Status), "url", h.URL)
+			httpDuration.add((time.Duration(h.Latency) * time.Microsecond).Seconds(), "url", h.URL)
+		}
+	}
+
+	tlsUp := &metricFamily{name: "probe_tls_up", typ: "gauge", help: "Whether the TLS handshake succeeded"}
+	tlsExpiry := &metricFamily{name: "probe_tls_cert_expiry_timestamp_seconds", typ: "gauge", help: "Unix time the certificate expires"}
+	for _, t := range status.TLS {
+		labels := []string{"addr", t.Addr, "sni", t.SNI}
+		tlsUp.add(boolValue(t.Error == ""), labels...)
+		if t.Expires != nil {
+			tlsExpiry.add(float64(t.Expires.Unix()), labels...)
+		}
+	}
+
+	return []*metricFamily{success, dur, dnsSuccess, dnsDuration, ipUp, ipRTT, ipLoss, tcpUp, tcpDuration, httpUp, httpStatus, httpDuration, tlsUp, tlsExpiry}
+}
+
+// HandleProbe returns an http.Handler that probes the target query parameter with the probes in the module query parameter,
+// and serves the results in the Prometheus text exposition format, like the blackbox exporter
+func (s *Service) HandleProbe() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		l := r.Context().Value(ContextKeyLog).(*Log)
+
+		target := r.URL.Query().Get("target")
+		if target == "" {
+			http.Error(w, "target parameter is missing", http.StatusBadRequest)
+			return
+		}
+
+		name := r.URL.Query().Get("module")
+		if name == "" {
+			name = defaultModule
+		}
+		module, ok := s.Modules[name]
+		if !ok {
+			names := make([]string, 0, len(s.Modules))
+			for n := range s.Modules {
+				names = append(names, n)
+			}
+			sort.Strings(names)
+			http.Error(w, fmt.Sprintf("unknown module %q: must be one of %v", name, names), http.StatusBadRequest)
+			return
+		}
+
+		start := time.Now()
+		status, err := s.Probe(module.host(target))
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			l.Error = &Error{fmt.Errorf("could not probe %s: %w", target, err)}
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		for _, f := range probeMetrics(status, time.Since(start)) {
+			if err := f.write(w); err != nil {
+				l.Error = &Error{fmt.Errorf("could not write metrics: %w", err)}
+				return
+			}
+		}
+	})
+}
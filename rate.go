@@ -1,10 +1,15 @@
 package main
 
 import (
+	"errors"
 	"net/http"
+	"sync"
+	"time"
 
 	tollbooth "github.com/didip/tollbooth/v6"
+	"github.com/didip/tollbooth/v6/libstring"
 	"github.com/didip/tollbooth/v6/limiter"
+	"golang.org/x/time/rate"
 )
 
 // LimitHandler is a middleware that performs rate-limiting
@@ -24,3 +29,78 @@ func LimitHandler(lmt *limiter.Limiter, next http.Handler) http.Handler {
 
 	return http.HandlerFunc(middle)
 }
+
+// FailureLimiter rate-limits failed authentication attempts per IP. Unlike a limiter.Limiter, requests that authenticate
+// successfully aren't counted, so clients that make many authenticated requests aren't limited
+type FailureLimiter struct {
+	limit     rate.Limit
+	burst     int
+	ipLookups []string
+
+	mu      sync.Mutex
+	buckets map[string]*rate.Limiter
+}
+
+// NewFailureLimiter returns a new FailureLimiter that allows perMinute failed attempts per minute from each IP
+func NewFailureLimiter(perMinute int, ipLookups []string) *FailureLimiter {
+	return &FailureLimiter{
+		limit:     rate.Limit(float64(perMinute) / 60),
+		burst:     perMinute,
+		ipLookups: ipLookups,
+		buckets:   make(map[string]*rate.Limiter),
+	}
+}
+
+// ip returns the IP r is limited by
+func (l *FailureLimiter) ip(r *http.Request) string {
+	return libstring.CanonicalizeIP(libstring.RemoteIP(l.ipLookups, 0, r))
+}
+
+// limited returns true if ip has no failed attempts left
+func (l *FailureLimiter) limited(ip string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	b, ok := l.buckets[ip]
+	return ok && b.Tokens() < 1
+}
+
+// fail records a failed attempt from ip
+func (l *FailureLimiter) fail(ip string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	// IPs that haven't failed recently don't need to be tracked
+	now := time.Now()
+	for k, b := range l.buckets {
+		if b.TokensAt(now) >= float64(l.burst) {
+			delete(l.buckets, k)
+		}
+	}
+
+	b, ok := l.buckets[ip]
+	if !ok {
+		b = rate.NewLimiter(l.limit, l.burst)
+		l.buckets[ip] = b
+	}
+	b.AllowN(now, 1)
+}
+
+// LimitFailures is a middleware that rejects requests from IPs that have failed authentication too often.
+// Requests with credentials that next rejects as unauthorized are counted as failed attempts
+func LimitFailures(lmt *FailureLimiter, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		l := r.Context().Value(ContextKeyLog).(*Log)
+		ip := lmt.ip(r)
+		if lmt.limited(ip) {
+			l.Error = &Error{errors.New("too many failed authentication attempts")}
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+
+		if l.Status == http.StatusUnauthorized && r.Header.Get("Authorization") != "" {
+			lmt.fail(ip)
+		}
+	})
+}
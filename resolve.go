@@ -0,0 +1,112 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/korylprince/ipscan/resolve"
+)
+
+// Family is an IP address family preference. Options use *Family so an unset family can be told apart from FamilyAny
+type Family string
+
+// Families
+const (
+	FamilyAny Family = ""
+	FamilyIP4 Family = "ip4"
+	FamilyIP6 Family = "ip6"
+)
+
+// familyAny is used for hosts that don't have a family set
+var familyAny = FamilyAny
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface
+func (f *Family) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+
+	switch fam := Family(s); fam {
+	case FamilyAny, FamilyIP4, FamilyIP6:
+		*f = fam
+	case "any":
+		*f = FamilyAny
+	default:
+		return fmt.Errorf("unknown family %q: must be one of ip4, ip6, any", s)
+	}
+
+	return nil
+}
+
+// IPFamily returns the Family of ip
+func IPFamily(ip net.IP) Family {
+	if ip.To4() != nil {
+		return FamilyIP4
+	}
+	return FamilyIP6
+}
+
+// lookup6 is a queued AAAA lookup
+type lookup6 struct {
+	hostname string
+	ips      []net.IP
+	err      error
+	done     chan struct{}
+}
+
+// Resolver wraps a resolve.Service to also resolve IPv6 (AAAA) addresses. AAAA lookups are queued to their own pool of workers
+type Resolver struct {
+	*resolve.Service
+	in6 chan *lookup6
+}
+
+// NewResolver returns a new *Resolver with the given amount of workers for each address family and queue size
+func NewResolver(workers, queue int) *Resolver {
+	r := &Resolver{Service: resolve.NewService(workers, queue), in6: make(chan *lookup6, queue)}
+	for i := 0; i < workers; i++ {
+		go r.resolver6()
+	}
+	return r
+}
+
+func (r *Resolver) resolver6() {
+	for l := range r.in6 {
+		l.ips, l.err = net.DefaultResolver.LookupIP(context.Background(), "ip6", l.hostname)
+		close(l.done)
+	}
+}
+
+// LookupIP returns the IPs of the given family resolved from hostname. If family is FamilyAny, an error is only returned if no IPs are found
+func (r *Resolver) LookupIP(hostname string, family Family) ([]net.IP, error) {
+	switch family {
+	case FamilyIP4:
+		return r.Service.LookupIP(hostname)
+	case FamilyIP6:
+		return r.lookupIP6(hostname)
+	}
+
+	ip4s, err4 := r.Service.LookupIP(hostname)
+	ip6s, err6 := r.lookupIP6(hostname)
+	if len(ip4s) == 0 && len(ip6s) == 0 {
+		if err4 != nil {
+			return nil, err4
+		}
+		return nil, err6
+	}
+
+	return append(ip4s, ip6s...), nil
+}
+
+// Queued6 returns the number of AAAA lookups waiting for a worker
+func (r *Resolver) Queued6() int {
+	return len(r.in6)
+}
+
+func (r *Resolver) lookupIP6(hostname string) ([]net.IP, error) {
+	l := &lookup6{hostname: hostname, done: make(chan struct{})}
+	r.in6 <- l
+	<-l.done
+	return l.ips, l.err
+}
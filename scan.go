@@ -1,29 +1,33 @@
 package main
 
 import (
+	"context"
 	"crypto/rand"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net"
+	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/gorilla/websocket"
 	"github.com/korylprince/ipscan/ping"
-	"github.com/korylprince/ipscan/resolve"
 	"golang.org/x/sync/errgroup"
 )
 
-type conn struct {
-	*websocket.Conn
-	mu *sync.Mutex
-}
-
 // Resolve is the result of DNS resolution
 type Resolve struct {
 	Hostname string
+	Family   Family
 	IPs      []net.IP
-	Error    error
+	// Name is the reverse DNS name of hosts that are IP addresses, if enabled
+	Name  string
+	Error error
+	// Duration is how long resolving the host took
+	Duration time.Duration
 }
 
 // MarshalJSON implements the json.Marshaler interface
@@ -31,11 +35,13 @@ func (r *Resolve) MarshalJSON() ([]byte, error) {
 	type resolve struct {
 		Type     string   `json:"t"`
 		Hostname string   `json:"h"`
+		Family   Family   `json:"f,omitempty"`
 		IPs      []string `json:"i,omitempty"`
+		Name     string   `json:"n,omitempty"`
 		Error    string   `json:"e,omitempty"`
 	}
 
-	res := &resolve{Type: "r", Hostname: r.Hostname}
+	res := &resolve{Type: "r", Hostname: r.Hostname, Family: r.Family, Name: r.Name}
 
 	if len(r.IPs) > 0 {
 		ips := make([]string, 0, len(r.IPs))
@@ -63,11 +69,12 @@ func (p *Ping) MarshalJSON() ([]byte, error) {
 	type ping struct {
 		Type    string `json:"t"`
 		IP      string `json:"i"`
+		Family  Family `json:"f"`
 		Latency int64  `json:"l"`
 		Error   string `json:"e,omitempty"`
 	}
 
-	pin := &ping{Type: "p", IP: p.IP.String()}
+	pin := &ping{Type: "p", IP: p.IP.String(), Family: IPFamily(p.IP)}
 
 	if p.Error != nil {
 		pin.Error = p.Error.Error()
@@ -85,108 +92,318 @@ func (p *Ping) MarshalJSON() ([]byte, error) {
 // Service is a ping service
 type Service struct {
 	Config   *Config
-	Resolver *resolve.Service
+	Resolver *Resolver
 	Pinger   *ping.Service
+	Pinger6  *Ping6Service
+	State    *State
+	// History is nil if history isn't enabled
+	History *History
+	// Alerter is nil if alerting isn't enabled
+	Alerter     *Alerter
+	Maintenance *Maintenance
+	Acks        *Acks
+	// Modules are the modules used by /probe
+	Modules  map[string]*ProbeModule
 	token    string
+	counters *counters
+	// probes limits how many dashboard re-probes run at once
+	probes chan struct{}
+	// rescan triggers a scan when the schema changes
+	rescan chan struct{}
 }
 
-// NewService returns a new Service
-func NewService(config *Config, resolver *resolve.Service, pinger *ping.Service) (*Service, error) {
+// NewService returns a new Service. If pinger6 is nil, IPv6 addresses aren't pinged
+func NewService(config *Config, resolver *Resolver, pinger *ping.Service, pinger6 *Ping6Service) (*Service, error) {
 	token := make([]byte, 32)
 	if _, err := rand.Read(token); err != nil {
 		return nil, fmt.Errorf("could not generate token: %w", err)
 	}
-	return &Service{Config: config, Resolver: resolver, Pinger: pinger, token: base64.RawURLEncoding.EncodeToString(token)}, nil
+	return &Service{
+		Config:   config,
+		Resolver: resolver,
+		Pinger:   pinger,
+		Pinger6:  pinger6,
+		State:    NewState(config.QueueSize),
+		token:    base64.RawURLEncoding.EncodeToString(token),
+		counters: new(counters),
+		probes:   make(chan struct{}, config.MaxProbes),
+		rescan:   make(chan struct{}, 1),
+	}, nil
 }
 
-func (s *Service) resolver(c *conn, hosts <-chan string, ips chan<- net.IP) error {
-	for h := range hosts {
-		is, err := s.Resolver.LookupIP(h)
-		for _, ip := range is {
-			ips <- ip
-		}
+// ping sends one ICMP or ICMPv6 echo request to ip, depending on its family
+func (s *Service) ping(ip net.IP) (*ping.Ping, error) {
+	atomic.AddInt64(&s.counters.pingsInFlight, 1)
+	defer atomic.AddInt64(&s.counters.pingsInFlight, -1)
 
-		c.mu.Lock()
-		if err := c.WriteJSON(&Resolve{Hostname: h, IPs: is, Error: err}); err != nil {
-			c.mu.Unlock()
-			return fmt.Errorf("could not write resolved message: %w", err)
-		}
-		c.mu.Unlock()
+	if IPFamily(ip) == FamilyIP4 {
+		return s.Pinger.Ping(ip)
 	}
-	return nil
+	if s.Pinger6 == nil {
+		return &ping.Ping{IP: ip, SentTime: time.Now()}, errors.New("ICMPv6 is not available")
+	}
+	return s.Pinger6.Ping(ip)
 }
 
-func (s *Service) pinger(c *conn, ips <-chan net.IP) error {
-	for ip := range ips {
-		p, err := s.Pinger.Ping(ip)
+// target is a resolved IP of a host, or the host itself for probes that aren't IP specific if ip is nil
+type target struct {
+	host *Host
+	ip   net.IP
+}
 
-		c.mu.Lock()
-		if err := c.WriteJSON(&Ping{Ping: p, Error: err}); err != nil {
-			c.mu.Unlock()
-			return fmt.Errorf("could not write pinged message: %w", err)
+func (s *Service) resolver(ctx context.Context, hosts <-chan *Host, targets chan<- *target, handle func(json.Marshaler) error) error {
+	for h := range hosts {
+		atomic.AddInt64(&s.counters.resolvesInFlight, 1)
+		start := time.Now()
+		is, err := s.Resolver.LookupIP(h.Host, *h.Family)
+		atomic.AddInt64(&s.counters.resolvesInFlight, -1)
+		res := &Resolve{Hostname: h.Host, Family: *h.Family, IPs: is, Error: err, Duration: time.Since(start)}
+		if ip := net.ParseIP(h.Host); ip != nil && h.Reverse != nil && *h.Reverse {
+			// hosts are still shown by address if they don't have a name
+			if name, err := s.Resolver.LookupAddr(ip); err == nil {
+				res.Name = strings.TrimSuffix(name, ".")
+			}
+		}
+		if err := handle(res); err != nil {
+			return fmt.Errorf("could not handle resolved message: %w", err)
+		}
+
+		if len(h.HTTP) > 0 || len(h.TLS) > 0 {
+			select {
+			case targets <- &target{host: h}:
+			case <-ctx.Done():
+				return nil
+			}
+		}
+
+		for _, ip := range is {
+			select {
+			case targets <- &target{host: h, ip: ip}:
+			case <-ctx.Done():
+				return nil
+			}
 		}
-		c.mu.Unlock()
 	}
 	return nil
 }
 
-// HandleConn resolves and pings all of the hosts in schema and handles the full converstion with ws
-func (s *Service) HandleConn(ws *websocket.Conn, schema Schema) (err error) {
-	if err = ws.WriteJSON(schema); err != nil {
-		return fmt.Errorf("could not write schema message: %w", err)
-	}
-
-	c := &conn{Conn: ws, mu: new(sync.Mutex)}
+func (s *Service) prober(targets <-chan *target, handle func(json.Marshaler) error) error {
+	for t := range targets {
+		if t.ip == nil {
+			for _, check := range t.host.HTTP {
+				if err := handle(ProbeHTTP(t.host.Host, check, t.host.timeout(s.Config.Timeout))); err != nil {
+					return fmt.Errorf("could not handle http message: %w", err)
+				}
+			}
+			for _, check := range t.host.TLS {
+				if err := handle(ProbeTLS(t.host.Host, check, t.host.timeout(s.Config.Timeout), s.Config.TLSWarnDays, s.Config.TLSCritDays)); err != nil {
+					return fmt.Errorf("could not handle tls message: %w", err)
+				}
+			}
+			continue
+		}
 
-	// defer closing ws
-	defer func() {
-		c.mu.Lock()
-		msg := ""
-		if err != nil {
-			msg = err.Error()
+		// without ICMPv6, IPv6 addresses are skipped instead of reported down, so IPv4 only servers work as before
+		if *t.host.ICMP && (s.Pinger6 != nil || IPFamily(t.ip) == FamilyIP4) {
+			p, stats := s.PingBurst(t.ip)
+			stats.Hostname = t.host.Host
+			if err := handle(p); err != nil {
+				return fmt.Errorf("could not handle pinged message: %w", err)
+			}
+			if err := handle(stats); err != nil {
+				return fmt.Errorf("could not handle stats message: %w", err)
+			}
 		}
-		if e := c.WriteJSON(map[string]string{"t": "c", "e": msg}); err != nil {
-			if err == nil {
-				err = fmt.Errorf("could not write close message: %w", e)
+
+		for _, port := range t.host.TCP {
+			tcp := ProbeTCP(t.ip, port, t.host.timeout(s.Config.Timeout))
+			tcp.Hostname = t.host.Host
+			if err := handle(tcp); err != nil {
+				return fmt.Errorf("could not handle tcp message: %w", err)
 			}
 		}
-		c.Close()
-		c.mu.Unlock()
-	}()
+	}
+	return nil
+}
+
+// Scan resolves and probes all of the hosts in schema, calling handle with every result. Scan stops at the first error returned by handle
+func (s *Service) Scan(schema Schema, handle func(json.Marshaler) error) error {
+	hosts := make(chan *Host)
+	targets := make(chan *target)
 
-	hosts := make(chan string)
-	ips := make(chan net.IP)
+	wg, ctx := errgroup.WithContext(context.Background())
 
-	wg1 := new(errgroup.Group)
+	resolvers := new(sync.WaitGroup)
 	for i := 0; i < s.Config.Resolvers; i++ {
-		wg1.Go(func() error {
-			return s.resolver(c, hosts, ips)
+		resolvers.Add(1)
+		wg.Go(func() error {
+			defer resolvers.Done()
+			return s.resolver(ctx, hosts, targets, handle)
 		})
 	}
 
-	wg2 := new(errgroup.Group)
+	// close targets once all resolvers are finished so probers exit
+	go func() {
+		resolvers.Wait()
+		close(targets)
+	}()
+
 	for i := 0; i < s.Config.Pingers; i++ {
-		wg2.Go(func() error {
-			return s.pinger(c, ips)
+		wg.Go(func() error {
+			return s.prober(targets, handle)
 		})
 	}
 
-	for _, hs := range schema {
-		for _, h := range hs.Hosts {
-			hosts <- h
+	wg.Go(func() error {
+		defer close(hosts)
+		for _, hs := range schema.Categories() {
+			for _, h := range hs.Hosts {
+				select {
+				case hosts <- h:
+				case <-ctx.Done():
+					return nil
+				}
+			}
 		}
-	}
+		return nil
+	})
 
-	close(hosts)
-	if err := wg1.Wait(); err != nil {
-		close(ips)
-		return fmt.Errorf("could not resolve hosts: %w", err)
-	}
+	return wg.Wait()
+}
+
+// HandleConn streams the current state and all future updates to ws until the client disconnects.
+// Clients can send Commands, and a CommandResponse is sent for each
+func (s *Service) HandleConn(ws *websocket.Conn) (err error) {
+	snapshot, updates := s.State.Subscribe()
+	defer func() {
+		// updates is nil while paused
+		if updates != nil {
+			s.State.Unsubscribe(updates)
+		}
+	}()
+
+	atomic.AddInt64(&s.counters.websockets, 1)
+	defer atomic.AddInt64(&s.counters.websockets, -1)
+
+	// done is closed when HandleConn returns so probes don't block sending their responses
+	done := make(chan struct{})
+	defer close(done)
+
+	// read commands from ws. This also processes control messages and notices disconnects
+	closed := make(chan struct{})
+	commands := make(chan *Command)
+	go func() {
+		defer close(closed)
+		for {
+			_, r, err := ws.NextReader()
+			if err != nil {
+				return
+			}
+			cmd := new(Command)
+			if err = json.NewDecoder(r).Decode(cmd); err != nil {
+				cmd = &Command{err: fmt.Errorf("could not parse command: %w", err)}
+			}
+			select {
+			case commands <- cmd:
+			case <-done:
+				return
+			}
+		}
+	}()
 
-	close(ips)
-	if err := wg2.Wait(); err != nil {
-		return fmt.Errorf("could not ping hosts: %w", err)
+	// defer closing ws
+	defer func() {
+		select {
+		case <-closed:
+		default:
+			msg := ""
+			if err != nil {
+				msg = err.Error()
+			}
+			if e := ws.WriteJSON(map[string]string{"t": "c", "e": msg}); e != nil {
+				if err == nil {
+					err = fmt.Errorf("could not write close message: %w", e)
+				}
+			}
+		}
+		ws.Close()
+	}()
+
+	for _, msg := range snapshot {
+		if err = ws.WriteJSON(msg); err != nil {
+			return fmt.Errorf("could not write snapshot message: %w", err)
+		}
 	}
 
-	return nil
+	// only one probe runs at a time for each client, and at most Config.MaxProbes across all clients
+	probing := false
+	responses := make(chan *CommandResponse)
+
+	for {
+		select {
+		case msg, ok := <-updates:
+			if !ok {
+				return errors.New("client could not keep up with updates")
+			}
+			if err = ws.WriteJSON(msg); err != nil {
+				return fmt.Errorf("could not write update message: %w", err)
+			}
+		case cmd := <-commands:
+			res := &CommandResponse{ID: cmd.ID, Command: cmd.Command}
+			switch {
+			case cmd.err != nil:
+				res.Error = cmd.err
+			case cmd.Command == CommandProbe:
+				var schema Schema
+				if schema, res.Hosts, res.Error = s.probeSchema(cmd); res.Error != nil {
+					break
+				}
+				if probing {
+					res.Hosts, res.Error = 0, errors.New("a probe is already running")
+					break
+				}
+				if !s.startProbe() {
+					res.Hosts, res.Error = 0, errors.New("too many probes are running, try again later")
+					break
+				}
+				probing = true
+				go func() {
+					defer s.endProbe()
+					res.Error = s.probe(schema)
+					select {
+					case responses <- res:
+					case <-done:
+					}
+				}()
+				continue
+			case cmd.Command == CommandPause:
+				if updates != nil {
+					s.State.Unsubscribe(updates)
+					updates = nil
+				}
+			case cmd.Command == CommandResume:
+				if updates != nil {
+					break
+				}
+				snapshot, updates = s.State.Subscribe()
+				for _, msg := range snapshot {
+					if err = ws.WriteJSON(msg); err != nil {
+						return fmt.Errorf("could not write snapshot message: %w", err)
+					}
+				}
+			default:
+				res.Error = fmt.Errorf("unknown command %q", cmd.Command)
+			}
+			if err = ws.WriteJSON(res); err != nil {
+				return fmt.Errorf("could not write command response: %w", err)
+			}
+		case res := <-responses:
+			probing = false
+			if err = ws.WriteJSON(res); err != nil {
+				return fmt.Errorf("could not write command response: %w", err)
+			}
+		case <-closed:
+			return nil
+		}
+	}
 }
@@ -0,0 +1,235 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/korylprince/ipscan/ping"
+)
+
+// newCommandService returns a Service for running a single scan from the command line
+func newCommandService(config *Config) (*Service, error) {
+	resolver := NewResolver(config.Resolvers, config.QueueSize)
+
+	pinger, _, err := ping.NewService(config.Pingers, config.QueueSize, config.Timeout, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not start ping service: %w", err)
+	}
+
+	pinger6, err := NewPing6Service(config.Timeout)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "warning: could not start ICMPv6 ping service, IPv6 addresses will not be pinged:", err)
+	}
+
+	return NewService(config, resolver, pinger, pinger6)
+}
+
+// ScanOnce scans every host in schema once and returns their statuses. A single failed observation is enough to mark a host down
+func (s *Service) ScanOnce(schema Schema) ([]*HostStatus, error) {
+	if err := s.scanOnce(s.State, schema); err != nil {
+		return nil, err
+	}
+	return s.State.HostStatuses(), nil
+}
+
+// scanOnce scans every host in schema once and stores the results and host states in state
+func (s *Service) scanOnce(state *State, schema Schema) error {
+	state.SetSchema(schema)
+	if err := s.Scan(schema, state.Update); err != nil {
+		return fmt.Errorf("could not scan hosts: %w", err)
+	}
+	state.UpdateHostStates(&StateConfig{FailThreshold: 1, RecoverThreshold: 1})
+	return nil
+}
+
+// filterSchema returns the categories of s with a path in categories, or nested in one, and the hosts in hosts.
+// Empty filters match everything
+func filterSchema(s Schema, categories, hosts []string) Schema {
+	var filter func(categories []*Category, matched bool) []*Category
+	filter = func(cs []*Category, matched bool) []*Category {
+		filtered := make([]*Category, 0, len(cs))
+		for _, c := range cs {
+			m := matched || len(categories) == 0 || containsString(categories, c.Path)
+			fc := *c
+			fc.Hosts = make([]*Host, 0, len(c.Hosts))
+			if m {
+				for _, h := range c.Hosts {
+					if len(hosts) == 0 || containsString(hosts, h.Host) {
+						fc.Hosts = append(fc.Hosts, h)
+					}
+				}
+			}
+			fc.Categories = filter(c.Categories, m)
+			if len(fc.Hosts) > 0 || len(fc.Categories) > 0 {
+				filtered = append(filtered, &fc)
+			}
+		}
+		return filtered
+	}
+	return filter(s, false)
+}
+
+// splitList splits a comma separated list, ignoring empty items
+func splitList(list string) []string {
+	items := make([]string, 0)
+	for _, item := range strings.Split(list, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+var scanColumns = []string{"HOST", "CATEGORY", "STATE", "IPS", "LATENCY", "LOSS", "PROBLEMS"}
+
+// scanRow returns the columns of status for table and CSV output
+func scanRow(status *HostStatus) []string {
+	ips := make([]string, 0, len(status.IPs))
+	for _, ip := range status.IPs {
+		ips = append(ips, ip.IP)
+	}
+
+	lat, loss := "-", "-"
+	sent, received, latency := status.PingSummary()
+	if received > 0 {
+		lat = latency.Round(time.Microsecond * 10).String()
+	}
+	if sent > 0 {
+		loss = fmt.Sprintf("%.0f%%", float64(sent-received)/float64(sent)*100)
+	}
+
+	category := ""
+	if len(status.Categories) > 0 {
+		category = status.Categories[0]
+	}
+
+	return []string{status.Host, category, string(status.State), strings.Join(ips, ","), lat, loss, strings.Join(status.Problems(), "; ")}
+}
+
+// printScan writes statuses to w in format
+func printScan(w io.Writer, format string, statuses []*HostStatus) error {
+	switch format {
+	case "json":
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(statuses)
+	case "csv":
+		cw := csv.NewWriter(w)
+		if err := cw.Write(scanColumns); err != nil {
+			return err
+		}
+		for _, status := range statuses {
+			if err := cw.Write(scanRow(status)); err != nil {
+				return err
+			}
+		}
+		cw.Flush()
+		return cw.Error()
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, strings.Join(scanColumns, "\t"))
+	for _, status := range statuses {
+		fmt.Fprintln(tw, strings.Join(scanRow(status), "\t"))
+	}
+	return tw.Flush()
+}
+
+// RunScan resolves and probes every host in the hosts file once and prints the results.
+// It returns the exit code: 1 if any host is down or unreachable, or 2 if the scan couldn't be run or the filters matched no hosts
+func RunScan(args []string) int {
+	config, err := LoadConfig()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 2
+	}
+
+	flags := flag.NewFlagSet("scan", flag.ContinueOnError)
+	format := flags.String("format", "table", "output format: table, json or csv")
+	categoryFilter := flags.String("category", "", "comma separated category paths to scan, including their nested categories")
+	hostFilter := flags.String("host", "", "comma separated hosts to scan")
+	stateFilter := flags.String("state", "", "comma separated states to print, e.g. down,unreachable")
+	maxExpand := flags.Int("max-expand", config.MaxExpand, "maximum amount of addresses a host can expand to")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: %s scan [flags] [hosts file]\n\n", os.Args[0])
+		fmt.Fprintln(flags.Output(), "Scans every host in the hosts file (default HOSTSPATH) once and prints the results. Flags:")
+		flags.PrintDefaults()
+	}
+	if err = flags.Parse(args); err != nil {
+		return 2
+	}
+
+	if *format != "table" && *format != "json" && *format != "csv" {
+		fmt.Fprintf(os.Stderr, "unknown format %q\n", *format)
+		return 2
+	}
+
+	states := splitList(*stateFilter)
+	for _, st := range states {
+		if _, ok := statusRank[Status(st)]; !ok {
+			fmt.Fprintf(os.Stderr, "unknown state %q\n", st)
+			return 2
+		}
+	}
+
+	path := config.HostsPath
+	if flags.NArg() > 1 {
+		flags.Usage()
+		return 2
+	} else if flags.NArg() == 1 {
+		path = flags.Arg(0)
+	}
+	if path == "" {
+		fmt.Fprintln(os.Stderr, "no hosts file given and HOSTSPATH isn't set")
+		return 2
+	}
+
+	schema, _, err := LoadSchema(path, *maxExpand)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 2
+	}
+	categories, hosts := splitList(*categoryFilter), splitList(*hostFilter)
+	schema = filterSchema(schema, categories, hosts)
+	if (len(categories) > 0 || len(hosts) > 0) && len(schema) == 0 {
+		fmt.Fprintf(os.Stderr, "%s not found in hosts file\n", strings.Join(append(categories, hosts...), ","))
+		return 2
+	}
+
+	svc, err := newCommandService(config)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 2
+	}
+
+	statuses, err := svc.ScanOnce(schema)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 2
+	}
+
+	// the exit code reflects every scanned host, not just the printed ones
+	code := 0
+	printed := make([]*HostStatus, 0, len(statuses))
+	for _, status := range statuses {
+		if isDown(status.State) {
+			code = 1
+		}
+		if len(states) == 0 || containsString(states, string(status.State)) {
+			printed = append(printed, status)
+		}
+	}
+
+	if err = printScan(os.Stdout, *format, printed); err != nil {
+		fmt.Fprintln(os.Stderr, "could not print results:", err)
+		return 2
+	}
+	return code
+}
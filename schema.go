@@ -2,16 +2,166 @@ package main
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
-
-	"gopkg.in/yaml.v2"
+	"strings"
+	"time"
 )
 
+// Host is a monitored host. In yaml, a Host is either a hostname or a mapping with a host key and options.
+// ICMP is nil if not set; it's enabled by default. TCP is a list of ports to probe with TCP connects.
+// Parent is a host or category the host depends on; if it's down, the host is unreachable instead of down.
+// Host can also be a CIDR network, IP range or IPv4 wildcard, which is expanded to a host for each address.
+// If Reverse is true and Host is an IP address, its name is looked up with reverse DNS.
+// Name, Description, Owner, Location and Tags are shown on the dashboard and included in alerts.
+// If Timeout is non-zero, it's used for TCP, HTTP and TLS probes instead of Config.Timeout.
+// If Probes is set, only the listed kinds of probes (icmp, tcp, http, tls) are run
+type Host struct {
+	Host    string       `yaml:"host"`
+	Parent  string       `yaml:"parent"`
+	Reverse *bool        `yaml:"reverse"`
+	Family  *Family      `yaml:"family"`
+	ICMP    *bool        `yaml:"icmp"`
+	TCP     []int        `yaml:"tcp"`
+	HTTP    []*HTTPCheck `yaml:"http"`
+	TLS     []*TLSCheck  `yaml:"tls"`
+
+	Name        string        `yaml:"name"`
+	Description string        `yaml:"description"`
+	Owner       string        `yaml:"owner"`
+	Location    string        `yaml:"location"`
+	Tags        []string      `yaml:"tags"`
+	Timeout     time.Duration `yaml:"timeout"`
+	Probes      []string      `yaml:"probes"`
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface
+func (h *Host) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var name string
+	if err := unmarshal(&name); err == nil {
+		h.Host = name
+		return nil
+	}
+
+	type host Host
+	if err := unmarshal((*host)(h)); err != nil {
+		return err
+	}
+	if h.Host == "" {
+		return errors.New("host must not be empty")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (h *Host) MarshalJSON() ([]byte, error) {
+	type host struct {
+		Host        string   `json:"host"`
+		Name        string   `json:"name,omitempty"`
+		Description string   `json:"description,omitempty"`
+		Owner       string   `json:"owner,omitempty"`
+		Location    string   `json:"location,omitempty"`
+		Tags        []string `json:"tags,omitempty"`
+	}
+
+	return json.Marshal(&host{
+		Host:        h.Host,
+		Name:        h.Name,
+		Description: h.Description,
+		Owner:       h.Owner,
+		Location:    h.Location,
+		Tags:        h.Tags,
+	})
+}
+
+// timeout returns the host's probe timeout, or def if it doesn't set one
+func (h *Host) timeout(def time.Duration) time.Duration {
+	if h.Timeout != 0 {
+		return h.Timeout
+	}
+	return def
+}
+
+// probeKinds are the kinds of probes that can be listed in Probes
+var probeKinds = []string{KindICMP, KindTCP, KindHTTP, KindTLS}
+
+// applyProbes disables the kinds of probes not listed in h.Probes, if it's set
+func (h *Host) applyProbes() error {
+	if len(h.Probes) == 0 {
+		return nil
+	}
+
+	enabled := make(map[string]bool)
+	for _, p := range h.Probes {
+		valid := false
+		for _, k := range probeKinds {
+			if p == k {
+				valid = true
+			}
+		}
+		if !valid {
+			return fmt.Errorf("unknown probe %q: must be one of %s", p, strings.Join(probeKinds, ", "))
+		}
+		enabled[p] = true
+	}
+
+	icmp := *h.ICMP && enabled[KindICMP]
+	h.ICMP = &icmp
+	if !enabled[KindTCP] {
+		h.TCP = nil
+	}
+	if !enabled[KindHTTP] {
+		h.HTTP = nil
+	}
+	if !enabled[KindTLS] {
+		h.TLS = nil
+	}
+	return nil
+}
+
+// Category is a named group of hosts. Parent, Reverse, Family, ICMP, TCP, HTTP, TLS, Timeout and Probes are used for hosts that don't set their own.
+// Categories can be nested to any depth, and nested categories inherit the same options. Path is the names of the category and its ancestors
+// joined with "/", e.g. "Site A/Building 1/Closet 2", and is how the category is referred to elsewhere.
+// An entry with Include is replaced with the categories in the files matching the glob, relative to the file it's in
+type Category struct {
+	Include    string        `json:"-" yaml:"include"`
+	Category   string        `json:"category" yaml:"category"`
+	Path       string        `json:"path" yaml:"-"`
+	Timeout    time.Duration `json:"-" yaml:"timeout"`
+	Probes     []string      `json:"-" yaml:"probes"`
+	Parent     string        `json:"-" yaml:"parent"`
+	Reverse    *bool         `json:"-" yaml:"reverse"`
+	Family     *Family       `json:"-" yaml:"family"`
+	ICMP       *bool         `json:"-" yaml:"icmp"`
+	TCP        []int         `json:"-" yaml:"tcp"`
+	HTTP       []*HTTPCheck  `json:"-" yaml:"http"`
+	TLS        []*TLSCheck   `json:"-" yaml:"tls"`
+	Hosts      []*Host       `json:"hosts" yaml:"hosts"`
+	Categories []*Category   `json:"categories,omitempty" yaml:"categories"`
+}
+
+// AllHosts returns the hosts in c and its nested categories
+func (c *Category) AllHosts() []*Host {
+	hosts := append(make([]*Host, 0, len(c.Hosts)), c.Hosts...)
+	for _, nested := range c.Categories {
+		hosts = append(hosts, nested.AllHosts()...)
+	}
+	return hosts
+}
+
 // Schema represents a yaml schema
-type Schema []struct {
-	Category string   `json:"category"`
-	Hosts    []string `json:"hosts"`
+type Schema []*Category
+
+// Categories returns every category in s, including nested categories. Categories are listed before their nested categories
+func (s Schema) Categories() []*Category {
+	categories := make([]*Category, 0, len(s))
+	for _, c := range s {
+		categories = append(categories, c)
+		categories = append(categories, Schema(c.Categories).Categories()...)
+	}
+	return categories
 }
 
 // MarshalJSON implements the json.Marshaler interface
@@ -26,13 +176,247 @@ func (s Schema) MarshalJSON() ([]byte, error) {
 	return json.Marshal(sch)
 }
 
-// UnmarshalSchema parses and returns a schema from r
-func UnmarshalSchema(r io.Reader) (Schema, error) {
-	s := make(Schema, 0)
-	dec := yaml.NewDecoder(r)
-	if err := dec.Decode(&s); err != nil {
+func validatePorts(ports []int) error {
+	for _, port := range ports {
+		if port < 1 || port > 65535 {
+			return fmt.Errorf("invalid port %d", port)
+		}
+	}
+	return nil
+}
+
+func validateHTTP(checks []*HTTPCheck) error {
+	for _, c := range checks {
+		if c.URL == "" {
+			c.URL = "http://{host}/"
+		}
+		if err := c.Validate(); err != nil {
+			return fmt.Errorf("http check %q: %w", c.URL, err)
+		}
+	}
+	return nil
+}
+
+func validateTLS(checks []*TLSCheck) error {
+	for _, c := range checks {
+		if err := c.Validate(); err != nil {
+			return fmt.Errorf("tls check on port %d: %w", c.Port, err)
+		}
+	}
+	return nil
+}
+
+// errDuplicateHost is returned if a category lists a host more than once
+var errDuplicateHost = errors.New("host is listed more than once in the category")
+
+// entryError is an error in a category or one of its hosts
+type entryError struct {
+	Category *Category
+	// Host is empty if the error is in the category
+	Host string
+	Err  error
+}
+
+func (e *entryError) Error() string {
+	if e.Host != "" {
+		return fmt.Sprintf("host %q: %v", e.Host, e.Err)
+	}
+	return fmt.Sprintf("category %q: %v", e.Category.Path, e.Err)
+}
+
+func (e *entryError) Unwrap() error {
+	return e.Err
+}
+
+// UnmarshalSchema parses and returns a schema from r. Includes are relative to the working directory. Hosts can't expand to more than maxExpand addresses
+func UnmarshalSchema(r io.Reader, maxExpand int) (Schema, error) {
+	buf, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("could not read schema: %w", err)
+	}
+
+	l := newSchemaLoader(maxExpand)
+	s, err := l.decode(new(schemaFile), buf)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode schema: %w", err)
+	}
+	if err = l.prepare(s); err != nil {
 		return nil, fmt.Errorf("could not decode schema: %w", err)
 	}
 
 	return s, nil
 }
+
+// prepareCategory sets the path of c, applies the options c inherits from parent (which is nil for top level categories),
+// validates c, and expands and applies options to its hosts. Nested categories are prepared recursively.
+// Errors are passed to report. If c's own options are invalid, its hosts and nested categories are skipped
+func prepareCategory(c, parent *Category, maxExpand int, report func(error)) {
+	c.Path = c.Category
+	if parent != nil {
+		c.Path = parent.Path + "/" + c.Category
+		if c.Parent == "" {
+			c.Parent = parent.Parent
+		}
+		if c.Reverse == nil {
+			c.Reverse = parent.Reverse
+		}
+		if c.Family == nil {
+			c.Family = parent.Family
+		}
+		if c.ICMP == nil {
+			c.ICMP = parent.ICMP
+		}
+		if c.TCP == nil {
+			c.TCP = parent.TCP
+		}
+		if c.HTTP == nil {
+			c.HTTP = parent.HTTP
+		}
+		if c.TLS == nil {
+			c.TLS = parent.TLS
+		}
+		if c.Timeout == 0 {
+			c.Timeout = parent.Timeout
+		}
+		if c.Probes == nil {
+			c.Probes = parent.Probes
+		}
+	}
+
+	if c.Category == "" {
+		report(&entryError{Category: c, Err: errors.New("category must not be empty")})
+		return
+	}
+	if err := validatePorts(c.TCP); err != nil {
+		report(&entryError{Category: c, Err: err})
+		return
+	}
+	if err := validateHTTP(c.HTTP); err != nil {
+		report(&entryError{Category: c, Err: err})
+		return
+	}
+	if err := validateTLS(c.TLS); err != nil {
+		report(&entryError{Category: c, Err: err})
+		return
+	}
+
+	hosts := make([]*Host, 0, len(c.Hosts))
+	for _, h := range c.Hosts {
+		expanded, err := expandHost(h, maxExpand)
+		if err != nil {
+			report(&entryError{Category: c, Host: h.Host, Err: err})
+			continue
+		}
+		hosts = append(hosts, expanded...)
+	}
+	c.Hosts = hosts
+	seen := make(map[string]struct{})
+	for _, h := range c.Hosts {
+		if _, ok := seen[h.Host]; ok {
+			report(&entryError{Category: c, Host: h.Host, Err: errDuplicateHost})
+			continue
+		}
+		seen[h.Host] = struct{}{}
+		if err := prepareHost(c, h); err != nil {
+			report(&entryError{Category: c, Host: h.Host, Err: err})
+		}
+	}
+
+	for _, nested := range c.Categories {
+		prepareCategory(nested, c, maxExpand, report)
+	}
+}
+
+// prepareHost applies the options h inherits from c and validates h
+func prepareHost(c *Category, h *Host) error {
+	// a category's parent can be one of its own hosts
+	if h.Parent == "" && c.Parent != h.Host {
+		h.Parent = c.Parent
+	}
+	if h.Reverse == nil {
+		h.Reverse = c.Reverse
+	}
+	if h.Family == nil {
+		h.Family = c.Family
+	}
+	if h.Family == nil {
+		h.Family = &familyAny
+	}
+	if h.ICMP == nil {
+		h.ICMP = c.ICMP
+	}
+	if h.ICMP == nil {
+		icmp := true
+		h.ICMP = &icmp
+	}
+	if h.TCP == nil {
+		h.TCP = c.TCP
+	}
+	if h.HTTP == nil {
+		h.HTTP = c.HTTP
+	}
+	if h.TLS == nil {
+		h.TLS = c.TLS
+	}
+	if h.Timeout == 0 {
+		h.Timeout = c.Timeout
+	}
+	if h.Probes == nil {
+		h.Probes = c.Probes
+	}
+
+	if err := h.applyProbes(); err != nil {
+		return err
+	}
+	if err := validatePorts(h.TCP); err != nil {
+		return err
+	}
+	if err := validateHTTP(h.HTTP); err != nil {
+		return err
+	}
+	return validateTLS(h.TLS)
+}
+
+// validateParents reports an error for every parent that doesn't exist and every category or host that is its own parent
+func validateParents(s Schema, report func(error)) {
+	categories := s.Categories()
+	names := make(map[string]struct{})
+	for _, c := range categories {
+		names[c.Path] = struct{}{}
+		for _, h := range c.Hosts {
+			names[h.Host] = struct{}{}
+		}
+	}
+
+	for _, c := range categories {
+		if c.Parent == "" {
+			continue
+		}
+		if _, ok := names[c.Parent]; !ok {
+			report(&entryError{Category: c, Err: fmt.Errorf("unknown parent %q", c.Parent)})
+		} else if c.Parent == c.Path {
+			report(&entryError{Category: c, Err: errors.New("category can't be its own parent")})
+		}
+	}
+
+	for _, c := range categories {
+		for _, h := range c.Hosts {
+			if h.Parent == "" {
+				continue
+			}
+			if _, ok := names[h.Parent]; !ok {
+				report(&entryError{Category: c, Host: h.Host, Err: fmt.Errorf("unknown parent %q", h.Parent)})
+			} else if h.Parent == h.Host {
+				report(&entryError{Category: c, Host: h.Host, Err: errors.New("host can't be its own parent")})
+			}
+		}
+	}
+}
+
+// LoadSchema reads and parses the schema at path and the files it includes. Hosts can't expand to more than maxExpand addresses.
+// The files and directories the schema was loaded from are returned, even if there's an error
+func LoadSchema(path string, maxExpand int) (Schema, []string, error) {
+	l := newSchemaLoader(maxExpand)
+	s, err := l.loadSchema(path)
+	return s, l.paths, err
+}
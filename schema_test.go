@@ -0,0 +1,111 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSchemaFamily(t *testing.T) {
+	schema, err := UnmarshalSchema(strings.NewReader(`
+- category: Default
+  hosts:
+    - default.example.com
+- category: IPv4
+  family: ip4
+  hosts:
+    - inherited.example.com
+    - host: ipv6.example.com
+      family: ip6
+    - host: any.example.com
+      family: any
+  categories:
+    - category: Nested
+      hosts:
+        - nested.example.com
+    - category: Nested Any
+      family: any
+      hosts:
+        - nested-any.example.com
+`), 256)
+	if err != nil {
+		t.Fatalf("could not parse schema: %v", err)
+	}
+
+	want := map[string]Family{
+		"default.example.com":    FamilyAny,
+		"inherited.example.com":  FamilyIP4,
+		"ipv6.example.com":       FamilyIP6,
+		"any.example.com":        FamilyAny,
+		"nested.example.com":     FamilyIP4,
+		"nested-any.example.com": FamilyAny,
+	}
+	for _, c := range schema.Categories() {
+		for _, h := range c.Hosts {
+			if *h.Family != want[h.Host] {
+				t.Errorf("%s family = %q, want %q", h.Host, *h.Family, want[h.Host])
+			}
+			delete(want, h.Host)
+		}
+	}
+	for host := range want {
+		t.Errorf("%s not in schema", host)
+	}
+}
+
+func TestLoadSchemaDuplicateHosts(t *testing.T) {
+	tests := []struct {
+		name  string
+		files map[string]string
+		err   string
+	}{
+		{
+			name: "same file",
+			files: map[string]string{
+				"hosts.yaml": "- category: A\n  hosts: [shared.example.com]\n- category: B\n  hosts: [shared.example.com]\n",
+			},
+		},
+		{
+			name: "same category",
+			files: map[string]string{
+				"hosts.yaml": "- category: A\n  hosts:\n    - shared.example.com\n    - shared.example.com\n",
+			},
+			err: "hosts.yaml:4: host \"shared.example.com\": " + errDuplicateHost.Error(),
+		},
+		{
+			name: "included files",
+			files: map[string]string{
+				"hosts.yaml":   "- category: A\n  hosts: [a.example.com]\n- include: teams/*.yaml\n",
+				"teams/b.yaml": "- category: B\n  hosts: [shared.example.com]\n",
+				"teams/c.yaml": "- category: C\n  hosts:\n    - c.example.com\n    - shared.example.com\n",
+			},
+			err: `teams/c.yaml:4: host "shared.example.com": already in category "B" at `,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dir := t.TempDir()
+			for name, content := range test.files {
+				path := filepath.Join(dir, name)
+				if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			_, _, err := LoadSchema(filepath.Join(dir, "hosts.yaml"), 256)
+			switch {
+			case test.err == "" && err != nil:
+				t.Errorf("unexpected error: %v", err)
+			case test.err != "" && err == nil:
+				t.Errorf("expected error %q", test.err)
+			case test.err != "" && !strings.Contains(err.Error(), test.err):
+				t.Errorf("error = %q, want %q", err, test.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,157 @@
+package main
+
+import (
+	"encoding/json"
+	"math"
+	"net"
+	"sync"
+	"time"
+)
+
+// Status is the classification of a probe result
+type Status string
+
+// Statuses
+const (
+	StatusUp       Status = "up"
+	StatusDegraded Status = "degraded"
+	StatusDown     Status = "down"
+)
+
+// Stats is the aggregate result of a burst of pings to a single IP. Hostname is the host the IP was resolved from
+type Stats struct {
+	Hostname string
+	IP       net.IP
+	Sent     int
+	Received int
+	Min      time.Duration
+	Avg      time.Duration
+	Max      time.Duration
+	StdDev   time.Duration
+	// Jitter is the mean difference in latency between consecutive responses
+	Jitter time.Duration
+	Status Status
+}
+
+// NewStats computes Stats from the given pings, which should be in the order they were sent.
+// Hosts losing at least degradedLoss percent of pings, or with an average latency of at least degradedLatency, are classified as degraded
+func NewStats(ip net.IP, pings []*Ping, degradedLoss float64, degradedLatency time.Duration) *Stats {
+	s := &Stats{IP: ip, Sent: len(pings)}
+
+	latencies := make([]time.Duration, 0, len(pings))
+	for _, p := range pings {
+		if p.Error == nil && p.Ping != nil && p.RecvTime != nil {
+			latencies = append(latencies, (*p.RecvTime).Sub(p.SentTime))
+		}
+	}
+	s.Received = len(latencies)
+
+	if s.Received == 0 {
+		s.Status = StatusDown
+		return s
+	}
+
+	var sum, jitter time.Duration
+	s.Min = latencies[0]
+	for i, l := range latencies {
+		sum += l
+		if l < s.Min {
+			s.Min = l
+		}
+		if l > s.Max {
+			s.Max = l
+		}
+		if i > 0 {
+			d := l - latencies[i-1]
+			if d < 0 {
+				d = -d
+			}
+			jitter += d
+		}
+	}
+	s.Avg = sum / time.Duration(s.Received)
+	if s.Received > 1 {
+		s.Jitter = jitter / time.Duration(s.Received-1)
+	}
+
+	var variance float64
+	for _, l := range latencies {
+		d := float64(l - s.Avg)
+		variance += d * d
+	}
+	s.StdDev = time.Duration(math.Sqrt(variance / float64(s.Received)))
+
+	s.Status = StatusUp
+	if s.Loss() >= degradedLoss || s.Avg >= degradedLatency {
+		s.Status = StatusDegraded
+	}
+
+	return s
+}
+
+// Loss returns the percentage of pings that weren't answered
+func (s *Stats) Loss() float64 {
+	if s.Sent == 0 {
+		return 0
+	}
+	return float64(s.Sent-s.Received) / float64(s.Sent) * 100
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (s *Stats) MarshalJSON() ([]byte, error) {
+	type stats struct {
+		Type     string  `json:"t"`
+		IP       string  `json:"i"`
+		Sent     int     `json:"n"`
+		Received int     `json:"r"`
+		Min      int64   `json:"mn"`
+		Avg      int64   `json:"av"`
+		Max      int64   `json:"mx"`
+		StdDev   int64   `json:"sd"`
+		Jitter   int64   `json:"j"`
+		Loss     float64 `json:"pl"`
+		Status   Status  `json:"st"`
+	}
+
+	return json.Marshal(&stats{
+		Type:     "e",
+		IP:       s.IP.String(),
+		Sent:     s.Sent,
+		Received: s.Received,
+		Min:      s.Min.Microseconds(),
+		Avg:      s.Avg.Microseconds(),
+		Max:      s.Max.Microseconds(),
+		StdDev:   s.StdDev.Microseconds(),
+		Jitter:   s.Jitter.Microseconds(),
+		Loss:     s.Loss(),
+		Status:   s.Status,
+	})
+}
+
+// PingBurst sends Config.Echoes pings to ip, spaced Config.EchoInterval apart, and returns the first answered ping (or the first ping if none were answered) and the burst's Stats
+func (s *Service) PingBurst(ip net.IP) (*Ping, *Stats) {
+	pings := make([]*Ping, s.Config.Echoes)
+	wg := new(sync.WaitGroup)
+	for i := 0; i < s.Config.Echoes; i++ {
+		if i > 0 {
+			time.Sleep(s.Config.EchoInterval)
+		}
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			p, err := s.ping(ip)
+			pings[i] = &Ping{Ping: p, Error: err}
+		}(i)
+	}
+	wg.Wait()
+
+	first := pings[0]
+	for _, p := range pings {
+		if p.Error == nil && p.RecvTime != nil {
+			first = p
+			break
+		}
+	}
+
+	return first, NewStats(ip, pings, s.Config.DegradedLoss, s.Config.DegradedLatency)
+}
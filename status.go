@@ -0,0 +1,217 @@
+package main
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+)
+
+// HostStatus is the latest state and probe results of a host. Latencies are in microseconds
+type HostStatus struct {
+	Host string `json:"host"`
+	Name string `json:"name,omitempty"`
+	// Categories are the paths of the categories the host is in, followed by their ancestors
+	Categories  []string   `json:"categories"`
+	Tags        []string   `json:"tags,omitempty"`
+	State       Status     `json:"state"`
+	Since       *time.Time `json:"since,omitempty"`
+	Cause       string     `json:"cause,omitempty"`
+	Flapping    bool       `json:"flapping"`
+	Maintenance bool       `json:"maintenance"`
+	// ReverseName is the reverse DNS name of hosts that are IP addresses, if enabled
+	ReverseName string `json:"reverse_name,omitempty"`
+	// Error is the resolution error, if any
+	Error          string        `json:"error,omitempty"`
+	ResolveLatency int64         `json:"resolve_latency"`
+	IPs            []*IPStatus   `json:"ips"`
+	HTTP           []*HTTPStatus `json:"http,omitempty"`
+	TLS            []*TLSStatus  `json:"tls,omitempty"`
+}
+
+// IPStatus is the latest probe results of an IP. Ping results are zero if the IP isn't pinged or hasn't been yet
+type IPStatus struct {
+	IP         string       `json:"ip"`
+	Family     Family       `json:"family"`
+	State      Status       `json:"state,omitempty"`
+	Sent       int          `json:"sent"`
+	Received   int          `json:"received"`
+	Loss       float64      `json:"loss"`
+	Latency    int64        `json:"latency"`
+	MinLatency int64        `json:"min_latency"`
+	MaxLatency int64        `json:"max_latency"`
+	TCP        []*TCPStatus `json:"tcp,omitempty"`
+}
+
+// TCPStatus is the latest result of a TCP connect probe
+type TCPStatus struct {
+	Port    int    `json:"port"`
+	Up      bool   `json:"up"`
+	Latency int64  `json:"latency,omitempty"`
+	Kind    string `json:"kind,omitempty"`
+	Error   string `json:"error,omitempty"`
+}
+
+// HTTPStatus is the latest result of an HTTP check
+type HTTPStatus struct {
+	URL     string `json:"url"`
+	Up      bool   `json:"up"`
+	Status  int    `json:"status,omitempty"`
+	Latency int64  `json:"latency,omitempty"`
+	Error   string `json:"error,omitempty"`
+}
+
+// TLSStatus is the latest result of a TLS certificate check
+type TLSStatus struct {
+	Addr     string     `json:"addr"`
+	SNI      string     `json:"sni"`
+	Status   string     `json:"status"`
+	Expires  *time.Time `json:"expires,omitempty"`
+	DaysLeft *int       `json:"days_left,omitempty"`
+	Error    string     `json:"error,omitempty"`
+}
+
+// PingSummary returns the total amount of echoes sent to and received from the host's IPs,
+// and the average latency of the IPs that responded
+func (h *HostStatus) PingSummary() (sent, received int, latency time.Duration) {
+	responded := 0
+	for _, ip := range h.IPs {
+		sent, received = sent+ip.Sent, received+ip.Received
+		if ip.Received > 0 {
+			latency += time.Duration(ip.Latency) * time.Microsecond
+			responded++
+		}
+	}
+	if responded > 0 {
+		latency /= time.Duration(responded)
+	}
+	return sent, received, latency
+}
+
+// Problems returns a short description of each failed probe
+func (h *HostStatus) Problems() []string {
+	problems := make([]string, 0)
+	if h.Error != "" {
+		problems = append(problems, h.Error)
+	}
+	for _, ip := range h.IPs {
+		if ip.Sent > 0 && ip.Received == 0 {
+			problems = append(problems, ip.IP+": no response")
+		}
+		for _, t := range ip.TCP {
+			if !t.Up {
+				problems = append(problems, fmt.Sprintf("%s: %s", net.JoinHostPort(ip.IP, strconv.Itoa(t.Port)), t.Kind))
+			}
+		}
+	}
+	for _, ht := range h.HTTP {
+		if !ht.Up {
+			problems = append(problems, fmt.Sprintf("%s: %s", ht.URL, ht.Error))
+		}
+	}
+	for _, t := range h.TLS {
+		switch {
+		case t.Error != "":
+			problems = append(problems, fmt.Sprintf("%s: %s", t.Addr, t.Error))
+		case t.Status != CertOK && t.DaysLeft != nil:
+			problems = append(problems, fmt.Sprintf("%s: certificate expires in %d days", t.Addr, *t.DaysLeft))
+		}
+	}
+	return problems
+}
+
+// HostStatuses returns the status of every host, in the order they're in the schema
+func (s *State) HostStatuses() []*HostStatus {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	categories := s.hostCategories()
+	statuses := make([]*HostStatus, 0)
+	seen := make(map[string]struct{})
+	for _, c := range s.schema.Categories() {
+		for _, h := range c.Hosts {
+			if _, ok := seen[h.Host]; ok {
+				continue
+			}
+			seen[h.Host] = struct{}{}
+			statuses = append(statuses, s.hostStatus(h, categories[h.Host]))
+		}
+	}
+	return statuses
+}
+
+// hostStatus returns the status of h. The caller must hold a lock
+func (s *State) hostStatus(h *Host, categories []string) *HostStatus {
+	status := &HostStatus{
+		Host:       h.Host,
+		Name:       h.Name,
+		Categories: categories,
+		Tags:       h.Tags,
+		State:      StatusUnknown,
+		IPs:        make([]*IPStatus, 0),
+	}
+
+	if hs, ok := s.hostStates[h.Host]; ok {
+		since := hs.Since
+		status.State, status.Since, status.Cause = hs.Status, &since, hs.Cause
+		status.Flapping, status.Maintenance = hs.Flapping, hs.Maintenance
+	}
+
+	if r, ok := s.resolves[h.Host]; ok {
+		status.ReverseName, status.ResolveLatency = r.Name, r.Duration.Microseconds()
+		if r.Error != nil {
+			status.Error = r.Error.Error()
+		}
+		for _, ip := range r.IPs {
+			is := &IPStatus{IP: ip.String(), Family: IPFamily(ip)}
+			if st, ok := s.stats[ip.String()]; ok && *h.ICMP {
+				is.State, is.Sent, is.Received, is.Loss = st.Status, st.Sent, st.Received, st.Loss()
+				is.Latency, is.MinLatency, is.MaxLatency = st.Avg.Microseconds(), st.Min.Microseconds(), st.Max.Microseconds()
+			}
+			for _, port := range h.TCP {
+				t, ok := s.tcps[(&TCP{IP: ip, Port: port}).Addr()]
+				if !ok {
+					continue
+				}
+				ts := &TCPStatus{Port: port, Up: t.Error == nil, Kind: t.Kind()}
+				if t.Error != nil {
+					ts.Error = t.Error.Error()
+				} else {
+					ts.Latency = t.Latency.Microseconds()
+				}
+				is.TCP = append(is.TCP, ts)
+			}
+			status.IPs = append(status.IPs, is)
+		}
+	}
+
+	for _, check := range h.HTTP {
+		ht, ok := s.https[httpKey(h.Host, check.ExpandURL(h.Host))]
+		if !ok {
+			continue
+		}
+		hs := &HTTPStatus{URL: ht.URL, Up: ht.Error == nil, Status: ht.Status, Latency: ht.Latency.Microseconds()}
+		if ht.Error != nil {
+			hs.Error = ht.Error.Error()
+		}
+		status.HTTP = append(status.HTTP, hs)
+	}
+
+	for _, check := range h.TLS {
+		t, ok := s.tlss[check.newTLS(h.Host).Key()]
+		if !ok {
+			continue
+		}
+		ts := &TLSStatus{Addr: t.Addr(), SNI: t.SNI, Status: t.Status}
+		if t.Cert != nil {
+			expires, days := t.Cert.NotAfter, t.DaysLeft()
+			ts.Expires, ts.DaysLeft = &expires, &days
+		}
+		if t.Error != nil {
+			ts.Error = t.Error.Error()
+		}
+		status.TLS = append(status.TLS, ts)
+	}
+
+	return status
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"net"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// TCP failure kinds
+const (
+	TCPRefused     = "refused"
+	TCPTimeout     = "timeout"
+	TCPUnreachable = "unreachable"
+	TCPError       = "error"
+)
+
+// TCP is the result of a TCP connect probe. Hostname is the host the IP was resolved from
+type TCP struct {
+	Hostname string
+	IP       net.IP
+	Port     int
+	Latency  time.Duration
+	Error    error
+}
+
+// Addr returns the host:port address of the probe
+func (t *TCP) Addr() string {
+	return net.JoinHostPort(t.IP.String(), strconv.Itoa(t.Port))
+}
+
+// Kind returns the kind of failure that occurred, or an empty string if the connection succeeded
+func (t *TCP) Kind() string {
+	if t.Error == nil {
+		return ""
+	}
+
+	var netErr net.Error
+	switch {
+	case errors.Is(t.Error, syscall.ECONNREFUSED):
+		return TCPRefused
+	case errors.As(t.Error, &netErr) && netErr.Timeout():
+		return TCPTimeout
+	case errors.Is(t.Error, syscall.EHOSTUNREACH), errors.Is(t.Error, syscall.ENETUNREACH):
+		return TCPUnreachable
+	}
+
+	return TCPError
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (t *TCP) MarshalJSON() ([]byte, error) {
+	type tcp struct {
+		Type    string `json:"t"`
+		IP      string `json:"i"`
+		Port    int    `json:"o"`
+		Family  Family `json:"f"`
+		Latency int64  `json:"l"`
+		Kind    string `json:"k,omitempty"`
+		Error   string `json:"e,omitempty"`
+	}
+
+	tc := &tcp{Type: "t", IP: t.IP.String(), Port: t.Port, Family: IPFamily(t.IP), Kind: t.Kind()}
+	if t.Error != nil {
+		tc.Error = t.Error.Error()
+	} else {
+		tc.Latency = t.Latency.Microseconds()
+	}
+
+	return json.Marshal(tc)
+}
+
+// ProbeTCP attempts a TCP connection to ip:port and returns the result
+func ProbeTCP(ip net.IP, port int, timeout time.Duration) *TCP {
+	t := &TCP{IP: ip, Port: port}
+	start := time.Now()
+	conn, err := net.DialTimeout("tcp", t.Addr(), timeout)
+	t.Latency = time.Since(start)
+	if err != nil {
+		t.Error = err
+		return t
+	}
+	conn.Close()
+
+	return t
+}
@@ -0,0 +1,172 @@
+package main
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"strconv"
+	"time"
+)
+
+// Certificate statuses
+const (
+	CertOK       = "ok"
+	CertWarning  = "warning"
+	CertCritical = "critical"
+)
+
+// TLSCheck configures a TLS certificate probe. If Port is zero, 443 is used. If SNI is empty, the hostname is used.
+// If WarnDays or CritDays are zero, Config.TLSWarnDays and Config.TLSCritDays are used
+type TLSCheck struct {
+	Port     int    `yaml:"port"`
+	SNI      string `yaml:"sni"`
+	WarnDays int    `yaml:"warn_days"`
+	CritDays int    `yaml:"crit_days"`
+}
+
+// Validate returns an error if the check is invalid
+func (c *TLSCheck) Validate() error {
+	if c.Port != 0 {
+		if err := validatePorts([]int{c.Port}); err != nil {
+			return err
+		}
+	}
+	if c.WarnDays < 0 || c.CritDays < 0 {
+		return errors.New("warn_days and crit_days must not be negative")
+	}
+	return nil
+}
+
+// TLS is the result of a TLS certificate probe
+type TLS struct {
+	Hostname string
+	Port     int
+	SNI      string
+	// Cert is the leaf certificate presented by the server
+	Cert *x509.Certificate
+	// VerifyError is the reason the certificate chain isn't trusted, if any
+	VerifyError error
+	Status      string
+	Error       error
+}
+
+// newTLS returns an unfinished TLS result for hostname with check's defaults applied
+func (c *TLSCheck) newTLS(hostname string) *TLS {
+	t := &TLS{Hostname: hostname, Port: c.Port, SNI: c.SNI, Status: CertCritical}
+	if t.Port == 0 {
+		t.Port = 443
+	}
+	if t.SNI == "" {
+		t.SNI = hostname
+	}
+	return t
+}
+
+// Key returns a key that uniquely identifies the probe
+func (t *TLS) Key() string {
+	return t.Addr() + "/" + t.SNI
+}
+
+// Addr returns the host:port address of the probe
+func (t *TLS) Addr() string {
+	return net.JoinHostPort(t.Hostname, strconv.Itoa(t.Port))
+}
+
+// DaysLeft returns the amount of whole days until the certificate expires. It's negative if the certificate has expired
+func (t *TLS) DaysLeft() int {
+	return int(math.Floor(time.Until(t.Cert.NotAfter).Hours() / 24))
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (t *TLS) MarshalJSON() ([]byte, error) {
+	type cert struct {
+		Type        string     `json:"t"`
+		Hostname    string     `json:"h"`
+		Addr        string     `json:"a"`
+		SNI         string     `json:"sni"`
+		Subject     string     `json:"sub,omitempty"`
+		Issuer      string     `json:"iss,omitempty"`
+		SANs        []string   `json:"san,omitempty"`
+		Expires     *time.Time `json:"ex,omitempty"`
+		DaysLeft    int        `json:"d"`
+		VerifyError string     `json:"ve,omitempty"`
+		Status      string     `json:"st"`
+		Error       string     `json:"e,omitempty"`
+	}
+
+	c := &cert{Type: "x", Hostname: t.Hostname, Addr: t.Addr(), SNI: t.SNI, Status: t.Status}
+
+	if t.Cert != nil {
+		c.Subject = t.Cert.Subject.String()
+		c.Issuer = t.Cert.Issuer.String()
+		c.SANs = append(c.SANs, t.Cert.DNSNames...)
+		for _, ip := range t.Cert.IPAddresses {
+			c.SANs = append(c.SANs, ip.String())
+		}
+		c.Expires = &t.Cert.NotAfter
+		c.DaysLeft = t.DaysLeft()
+	}
+
+	if t.VerifyError != nil {
+		c.VerifyError = t.VerifyError.Error()
+	}
+
+	if t.Error != nil {
+		c.Error = t.Error.Error()
+	}
+
+	return json.Marshal(c)
+}
+
+// ProbeTLS performs a TLS handshake with hostname as configured by check and returns the result.
+// warnDays and critDays are used if check doesn't set its own
+func ProbeTLS(hostname string, check *TLSCheck, timeout time.Duration, warnDays, critDays int) *TLS {
+	t := check.newTLS(hostname)
+	if check.WarnDays != 0 {
+		warnDays = check.WarnDays
+	}
+	if check.CritDays != 0 {
+		critDays = check.CritDays
+	}
+
+	// verification is done below so the certificate can be inspected even if it's not trusted
+	conn, err := tls.DialWithDialer(&net.Dialer{Timeout: timeout}, "tcp", t.Addr(), &tls.Config{
+		ServerName:         t.SNI,
+		InsecureSkipVerify: true,
+	})
+	if err != nil {
+		t.Error = fmt.Errorf("could not complete handshake: %w", err)
+		return t
+	}
+	defer conn.Close()
+
+	certs := conn.ConnectionState().PeerCertificates
+	if len(certs) == 0 {
+		t.Error = errors.New("no certificates presented")
+		return t
+	}
+	t.Cert = certs[0]
+
+	intermediates := x509.NewCertPool()
+	for _, c := range certs[1:] {
+		intermediates.AddCert(c)
+	}
+	if _, err = t.Cert.Verify(x509.VerifyOptions{DNSName: t.SNI, Intermediates: intermediates}); err != nil {
+		t.VerifyError = err
+	}
+
+	switch days := t.DaysLeft(); {
+	case days < critDays:
+		t.Status = CertCritical
+	case days < warnDays:
+		t.Status = CertWarning
+	default:
+		t.Status = CertOK
+	}
+
+	return t
+}
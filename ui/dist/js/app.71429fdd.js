@@ -0,0 +1,410 @@
+(function(r){function t(t){for(var s,i,l=t[0],a=t[1],c=t[2],p=0,h=[];p<l.length;p++)i=l[p],Object.prototype.hasOwnProperty.call(o,i)&&o[i]&&h.push(o[i][0]),o[i]=0;for(s in a)Object.prototype.hasOwnProperty.call(a,s)&&(r[s]=a[s]);u&&u(t);while(h.length)h.shift()();return n.push.apply(n,c||[]),e()}function e(){for(var r,t=0;t<n.length;t++){for(var e=n[t],s=!0,l=1;l<e.length;l++){var a=e[l];0!==o[a]&&(s=!1)}s&&(n.splice(t--,1),r=i(i.s=e[0]))}return r}var s={},o={app:0},n=[];function i(t){if(s[t])return s[t].exports;var e=s[t]={i:t,l:!1,exports:{}};return r[t].call(e.exports,e,e.exports,i),e.l=!0,e.exports}i.m=r,i.c=s,i.d=function(r,t,e){i.o(r,t)||Object.defineProperty(r,t,{enumerable:!0,get:e})},i.r=function(r){"undefined"!==typeof Symbol&&Symbol.toStringTag&&Object.defineProperty(r,Symbol.toStringTag,{value:"Module"}),Object.defineProperty(r,"__esModule",{value:!0})},i.t=function(r,t){if(1&t&&(r=i(r)),8&t)return r;if(4&t&&"object"===typeof r&&r&&r.__esModule)return r;var e=Object.create(null);if(i.r(e),Object.defineProperty(e,"default",{enumerable:!0,value:r}),2&t&&"string"!=typeof r)for(var s in r)i.d(e,s,function(t){return r[t]}.bind(null,s));return e},i.n=function(r){var t=r&&r.__esModule?function(){return r["default"]}:function(){return r};return i.d(t,"a",t),t},i.o=function(r,t){return Object.prototype.hasOwnProperty.call(r,t)},i.p="/";var l=window["webpackJsonp"]=window["webpackJsonp"]||[],a=l.push.bind(l);l.push=t,l=l.slice();for(var c=0;c<l.length;c++)t(l[c]);var u=a;n.push([0,"chunk-vendors"]),e()})({0:function(module,exports,require){module.exports=require("56d7")},"56d7":function(module,exports,require){"use strict";require.r(exports);var Vue=require("2b0e")["a"];
+// ipStatus returns the combined status of all of an IP's probe results, or null if there aren't any yet
+function ipStatus(ip) {
+    const statuses = []
+    if (ip.stats != null) {
+        statuses.push(ip.stats.st)
+    } else if (ip.error != null) {
+        statuses.push("down")
+    } else if (ip.latency != null) {
+        statuses.push("up")
+    }
+    for (const tcp of Object.values(ip.tcp)) {
+        statuses.push(tcp.e == null ? "up" : "down")
+    }
+
+    if (statuses.length === 0) {
+        return null
+    }
+    if (statuses.every(st => st === "up")) {
+        return "up"
+    }
+    if (statuses.every(st => st === "down")) {
+        return "down"
+    }
+    return "degraded"
+}
+
+// hostStatuses returns the statuses of all of a host's probe results, with null for IPs that don't have results yet
+function hostStatuses(host) {
+    const statuses = host.ips.map(ipStatus)
+    for (const res of Object.values(host.http)) {
+        statuses.push(res.e == null ? "up" : "down")
+    }
+    for (const cert of Object.values(host.tls)) {
+        statuses.push({ok: "up", warning: "degraded", critical: "down"}[cert.st])
+    }
+    return statuses
+}
+
+// newCategory returns a category and its nested categories from the schema, using getHosts to get each category's hosts
+function newCategory(category, getHosts) {
+    return {
+        category: category.category,
+        path: category.path,
+        hosts: getHosts(category),
+        categories: (category.categories || []).map(c => newCategory(c, getHosts)),
+    }
+}
+
+// flattenCategories returns categories and their nested categories in order, with their nesting depth and the paths of their ancestors
+function flattenCategories(categories, ancestors = []) {
+    let flat = []
+    for (const category of categories) {
+        flat.push({...category, depth: ancestors.length, ancestors})
+        flat = flat.concat(flattenCategories(category.categories, ancestors.concat([category.path])))
+    }
+    return flat
+}
+
+// newHost returns a host without any results from its schema attributes
+function newHost(attrs) {
+    return {host: attrs.host, attrs, name: null, ips: [], http: {}, tls: {}, state: null, error: null}
+}
+
+// ipSortKey returns a string that sorts IPv4 addresses before IPv6 addresses, and each family numerically
+function ipSortKey(ip) {
+    if (!ip.includes(":")) {
+        return "4" + ip.split(".").map(octet => octet.padStart(3, "0")).join("")
+    }
+    let groups = ip.split(":")
+    if (ip.includes("::")) {
+        const [head, tail] = ip.split("::")
+        const headGroups = head === "" ? [] : head.split(":")
+        const tailGroups = tail === "" ? [] : tail.split(":")
+        const fill = new Array(8 - headGroups.length - tailGroups.length).fill("0")
+        groups = headGroups.concat(fill, tailGroups)
+    }
+    return "6" + groups.map(group => group.padStart(4, "0")).join("")
+}
+
+var App = {
+    data() {
+        return {
+            categories: [],
+            hostsIdx: {},
+            hostCategories: {},
+            aggregates: {},
+            collapsed: {},
+            ipIdx: {},
+            acks: {},
+            silences: [],
+            error: null,
+            schemaError: null,
+            commandError: null,
+            // probing is the id of the running probe command
+            probing: null,
+            paused: false,
+            nextID: 1,
+        }
+    },
+    methods: {
+        ipStatus,
+        // indexHosts rebuilds the host indexes from categories. A host is in its categories' ancestors too
+        indexHosts() {
+            const hostsIdx = {}
+            const hostCategories = {}
+            const index = (categories, ancestors) => {
+                for (const category of categories) {
+                    const paths = [category.path].concat(ancestors)
+                    for (const h of category.hosts) {
+                        if (h.host in hostsIdx) {
+                            hostsIdx[h.host].push(h)
+                        } else {
+                            hostsIdx[h.host] = [h]
+                        }
+                        hostCategories[h.host] = (hostCategories[h.host] || []).concat(paths)
+                    }
+                    index(category.categories, paths)
+                }
+            }
+            index(this.categories, [])
+            this.hostsIdx = hostsIdx
+            this.hostCategories = hostCategories
+        },
+        toggle(category) {
+            if (category.path != null) {
+                this.$set(this.collapsed, category.path, !this.collapsed[category.path])
+            }
+        },
+        // send sends a command over the websocket and returns its id
+        send(command) {
+            const id = String(this.nextID++)
+            this.socket.send(JSON.stringify({...command, i: id}))
+            return id
+        },
+        probe(target) {
+            this.probing = this.send({c: "probe", ...target})
+        },
+        togglePause() {
+            this.send({c: this.paused ? "resume" : "pause"})
+        },
+        hostAck(host) {
+            return this.acks[host.host]
+        },
+        hostSilence(host) {
+            const categories = this.hostCategories[host.host] || []
+            return this.silences.find(s => s.host === host.host || categories.includes(s.category))
+        },
+    },
+    computed: {
+        errors() {
+            const errors = []
+            for (const category of flattenCategories(this.categories)) {
+                for (const host of category.hosts) {
+                    if (host.state != null && host.state.m) {
+                        continue
+                    }
+                    if (host.error != null) {
+                        errors.push(host)
+                        continue
+                    }
+                    const statuses = hostStatuses(host)
+                    if (statuses.some(st => st != null && st !== "up")) {
+                        errors.push(host)
+                    }
+                }
+            }
+            // root causes are shown before hosts that are unreachable because of them
+            const unreachable = host => host.state != null && host.state.st === "unreachable" ? 1 : 0
+            errors.sort((h1, h2) => unreachable(h1) - unreachable(h2) || h1.host.localeCompare(h2.host))
+            return {category: "Errors", path: null, hosts: errors, categories: [], depth: 0}
+        },
+        computedCategories() {
+            // nested categories of collapsed categories are hidden
+            const categories = flattenCategories(this.categories).filter(c => !c.ancestors.some(path => this.collapsed[path]))
+            const errors = this.errors
+            if (errors.hosts.length === 0) {
+                return categories
+            }
+            return ([errors]).concat(categories)
+        },
+    },
+    filters: {
+        color(host) {
+            if (host.state != null && host.state.m) {
+                return {backgroundColor: "#cfe2f3"}
+            }
+            if (host.state != null && host.state.st === "unreachable") {
+                return {backgroundColor: "#d9d9d9"}
+            }
+            const statuses = hostStatuses(host)
+            if ((host.error == null && statuses.length === 0) || statuses.includes(null)) {
+                return {backgroundColor: "#c9daf8"}
+            }
+            if (host.error != null || statuses.every(st => st === "down")) {
+                return {backgroundColor: "#f4cccc"}
+            }
+            if (statuses.some(st => st !== "up")) {
+                return {backgroundColor: "#fce5cd"}
+            }
+            return {backgroundColor: "#b7e1cd"}
+        },
+        countsColor(counts) {
+            return {backgroundColor: {
+                up: "#b7e1cd", degraded: "#fce5cd", down: "#f4cccc", unreachable: "#d9d9d9", unknown: "#c9daf8",
+            }[counts.st]}
+        },
+        countsLabel(counts) {
+            const parts = [`${counts.up} up`]
+            for (const st of ["degraded", "down", "unreachable"]) {
+                if (counts[st] > 0) {
+                    parts.push(`${counts[st]} ${st}`)
+                }
+            }
+            if (counts.m > 0) {
+                parts.push(`${counts.m} in maintenance`)
+            }
+            return parts.join(", ")
+        },
+        stateLabel(state) {
+            if (state.st === "unreachable") {
+                return `unreachable (${state.c} down)`
+            }
+            return state.st
+        },
+        certTitle(cert) {
+            let title = `SNI: ${cert.sni}`
+            if (cert.sub != null) {
+                title += `\nsubject: ${cert.sub}\nissuer: ${cert.iss}\nexpires: ${cert.ex}`
+            }
+            if (cert.san != null) {
+                title += `\nSANs: ${cert.san.join(", ")}`
+            }
+            if (cert.ve != null) {
+                title += `\nnot trusted: ${cert.ve}`
+            }
+            return title
+        },
+        httpTitle(res) {
+            if (res.tls == null) {
+                return ""
+            }
+            return `${res.tls.v} ${res.tls.cs}\n${res.tls.sub}\nexpires ${res.tls.ex}`
+        },
+        statsTitle(stats) {
+            return `${stats.r}/${stats.n} received\n` +
+                `min/avg/max/stddev: ${stats.mn/1000}/${stats.av/1000}/${stats.mx/1000}/${stats.sd/1000}ms`
+        },
+    },
+    async created() {
+        let proto = "wss://"
+        if (window.location.protocol == "http:") {
+            proto = "ws://"
+        }
+        const socket = new WebSocket(`${proto}${window.location.host}/ws`)
+        this.socket = socket
+
+        socket.addEventListener("error", event => {
+            this.error = JSON.stringify(event)
+            console.error({msg: "websocket error:", error: event})
+        })
+
+        socket.addEventListener("message", event => {
+            const msg = JSON.parse(event.data)
+            switch (msg.t) {
+                case "u":
+                    window.location = "/auth"
+                    break
+                case "s":
+                    this.categories = msg.s.map(category => newCategory(category, c => c.hosts.map(newHost)))
+                    this.indexHosts()
+                    break
+                case "d": {
+                    // keep existing hosts and their results, and only replace the hosts of categories whose hosts changed
+                    const old = {}
+                    for (const c of flattenCategories(this.categories)) {
+                        old[c.path] = c
+                    }
+                    this.categories = msg.o.map(category => newCategory(category, ({path}) => {
+                        if (!(path in msg.c)) {
+                            return old[path].hosts
+                        }
+                        const prev = {}
+                        if (path in old) {
+                            for (const h of old[path].hosts) {
+                                prev[h.host] = h
+                            }
+                        }
+                        return msg.c[path].map(attrs => {
+                            if (attrs.host in prev) {
+                                return prev[attrs.host]
+                            }
+                            if (attrs.host in this.hostsIdx) {
+                                return {...this.hostsIdx[attrs.host][0]}
+                            }
+                            return newHost(attrs)
+                        })
+                    }))
+                    this.indexHosts()
+                    // checks may have changed, so wait for new results
+                    for (const attrs of msg.u) {
+                        for (const h of this.hostsIdx[attrs.host] || []) {
+                            h.attrs = attrs
+                            h.http = {}
+                            h.tls = {}
+                        }
+                    }
+                    break
+                }
+                case "g":
+                    this.aggregates = msg.g
+                    break
+                case "y":
+                    this.schemaError = msg.e != null ? msg.e : null
+                    break
+                case "r": {
+                    if (!(msg.h in this.hostsIdx)) {
+                        break
+                    }
+                    const ips = []
+                    if (msg.i != null) {
+                        for (const ip of msg.i) {
+                            if (!(ip in this.ipIdx)) {
+                                this.ipIdx[ip] = {ip, family: null, latency: null, sortKey: ipSortKey(ip), error: null, stats: null, tcp: {}}
+                            }
+                            ips.push(this.ipIdx[ip])
+                        }
+                        ips.sort((ip1, ip2) => ip1.sortKey.localeCompare(ip2.sortKey))
+                    }
+                    for (const host of this.hostsIdx[msg.h]) {
+                        host.ips = ips
+                        host.name = msg.n != null ? msg.n : null
+                        host.error = msg.e != null ? msg.e : null
+                    }
+                    break
+                }
+                case "p":
+                    if (!(msg.i in this.ipIdx)) {
+                        this.ipIdx[msg.i] = {
+                            ip: msg.i, family: msg.f, latency: msg.l, sortKey: ipSortKey(msg.i), error: msg.e, stats: null, tcp: {},
+                        }
+                        return
+                    }
+                    this.ipIdx[msg.i].family = msg.f
+                    this.ipIdx[msg.i].latency = msg.l
+                    this.ipIdx[msg.i].error = msg.e
+                    break
+                case "e":
+                    if (msg.i in this.ipIdx) {
+                        this.ipIdx[msg.i].stats = msg
+                    }
+                    break
+                case "w":
+                    if (msg.h in this.hostsIdx) {
+                        for (const host of this.hostsIdx[msg.h]) {
+                            this.$set(host.http, msg.u, msg)
+                        }
+                    }
+                    break
+                case "h":
+                    if (msg.h in this.hostsIdx) {
+                        for (const host of this.hostsIdx[msg.h]) {
+                            host.state = msg
+                        }
+                    }
+                    break
+                case "a": {
+                    const acks = {}
+                    for (const ack of msg.a) {
+                        acks[ack.host] = ack
+                    }
+                    this.acks = acks
+                    this.silences = msg.s
+                    break
+                }
+                case "x":
+                    if (msg.h in this.hostsIdx) {
+                        for (const host of this.hostsIdx[msg.h]) {
+                            this.$set(host.tls, `${msg.a}/${msg.sni}`, msg)
+                        }
+                    }
+                    break
+                case "t":
+                    if (msg.i in this.ipIdx) {
+                        this.$set(this.ipIdx[msg.i].tcp, msg.o, msg)
+                    }
+                    break
+                case "k":
+                    this.commandError = msg.e != null ? `Could not ${msg.c || "run command"}: ${msg.e}` : null
+                    if (msg.c === "probe" && msg.i === this.probing) {
+                        this.probing = null
+                    } else if (msg.c === "pause" && msg.e == null) {
+                        this.paused = true
+                    } else if (msg.c === "resume" && msg.e == null) {
+                        this.paused = false
+                    }
+                    break
+                case "c":
+                    if (msg.e != null) {
+                        this.error = msg.e
+                    }
+            }
+        })
+    },
+}
+
+App.render=new Function("with(this){return _c(\"div\",{staticClass:\"app\"},[(error)?_c(\"div\",{staticClass:\"error\"},[_v(\"Error: \"+_s(error))],2):_e(),(schemaError)?_c(\"div\",{staticClass:\"error\"},[_v(\"Could not reload hosts file, using previous hosts: \"+_s(schemaError))],2):_e(),(commandError)?_c(\"div\",{staticClass:\"error\"},[_v(_s(commandError))],2):_e(),_c(\"div\",{staticClass:\"controls\"},[_c(\"button\",{on:{\"click\":togglePause}},[_v(_s(paused ? \"Resume updates\" : \"Pause updates\"))],2)],2),_l((computedCategories),function(category,idx){return _c(\"div\",{key:idx,staticClass:\"category\",style:({paddingLeft: `${category.depth * 20}px`})},[_c(\"div\",{staticClass:\"category-header\",on:{\"click\":function($event){return toggle(category)}}},[_c(\"div\",{staticClass:\"category-name\"},[(category.path != null)?_c(\"span\",{staticClass:\"category-toggle\"},[_v(_s(collapsed[category.path] ? \"▸\" : \"▾\"))],2):_e(),_v(\" \"+_s(category.category)+\" \")],2),(aggregates[category.path] != null)?_c(\"div\",{staticClass:\"category-counts\",style:(_f(\"countsColor\")(aggregates[category.path]))},[_v(\" \"+_s(_f(\"countsLabel\")(aggregates[category.path]))+\" \")],2):_e(),(category.path != null)?_c(\"button\",{staticClass:\"probe\",attrs:{\"disabled\":probing != null},on:{\"click\":function($event){$event.stopPropagation();return probe({g: category.path})}}},[_v(\"re-probe\")],2):_e()],2),(!collapsed[category.path])?_c(\"div\",{staticClass:\"hosts\"},[_l((category.hosts),function(host,idx){return _c(\"div\",{key:idx,staticClass:\"host\",style:(_f(\"color\")(host))},[_c(\"div\",{staticClass:\"host-name\",attrs:{\"title\":host.attrs.description}},[_v(_s(host.attrs.name || host.name || host.host))],2),(host.attrs.name || host.name)?_c(\"div\",{staticClass:\"host-address\"},[_v(_s(host.host))],2):_e(),(host.attrs.owner || host.attrs.location)?_c(\"div\",{staticClass:\"host-attrs\"},[_v(\" \"+_s([host.attrs.location, host.attrs.owner].filter(a => a).join(\" · \"))+\" \")],2):_e(),(host.attrs.tags)?_c(\"div\",{staticClass:\"host-tags\"},[_l((host.attrs.tags),function(tag){return _c(\"span\",{key:tag,staticClass:\"host-tag\"},[_v(_s(tag))],2)})],2):_e(),(host.state != null && host.state.st !== 'unknown')?_c(\"div\",{staticClass:\"host-state\"},[_v(\" \"+_s(_f(\"stateLabel\")(host.state))+\" since \"+_s(new Date(host.state.since).toLocaleString())+\" \"),(host.state.fl)?_c(\"span\",{staticClass:\"host-flapping\"},[_v(\"flapping\")],2):_e(),(host.state.m)?_c(\"span\",{staticClass:\"host-maintenance\"},[_v(\"in maintenance\")],2):_e()],2):_e(),(hostAck(host) != null)?_c(\"div\",{staticClass:\"host-ack\",attrs:{\"title\":hostAck(host).comment}},[_v(\" acknowledged by \"+_s(hostAck(host).user)),(hostAck(host).comment)?_c(\"span\",undefined,[_v(\": \"+_s(hostAck(host).comment))],2):_e()],2):_e(),(hostSilence(host) != null)?_c(\"div\",{staticClass:\"host-ack\",attrs:{\"title\":hostSilence(host).comment}},[_v(\" silenced by \"+_s(hostSilence(host).user)+\" until \"+_s(new Date(hostSilence(host).expires).toLocaleString())+\" \")],2):_e(),_c(\"div\",{directives:[{name:\"show\",rawName:\"v-show\",value:(host.ips.length === 0 && host.error == null),expression:\"host.ips.length === 0 && host.error == null\"}],staticClass:\"loading\"}),_c(\"div\",{staticClass:\"ips\"},[_l((host.ips),function(ip,idx){return _c(\"div\",{key:idx,staticClass:\"ip\"},[_c(\"div\",{staticClass:\"ip-ip\",class:{'ip-ip6': ip.family === 'ip6'}},[_v(_s(ip.ip)+\" \"),_c(\"div\",{directives:[{name:\"show\",rawName:\"v-show\",value:(ipStatus(ip) == null),expression:\"ipStatus(ip) == null\"}],staticClass:\"loading\"}),_c(\"div\",{directives:[{name:\"show\",rawName:\"v-show\",value:(ip.latency != null && ip.error == null),expression:\"ip.latency != null && ip.error == null\"}],staticClass:\"ip-latency\"},[_v(_s(ip.latency/1000)+\"ms\")],2),(ip.error != null)?_c(\"div\",{staticClass:\"ip-error\"},[_v(\"No Response\")],2):_e(),(ip.stats != null && ip.stats.r > 0)?_c(\"div\",{staticClass:\"ip-stats\",class:{'ip-degraded': ip.stats.st === 'degraded'},attrs:{\"title\":_f(\"statsTitle\")(ip.stats)}},[_v(\" \"+_s(ip.stats.pl.toFixed(0))+\"% loss, ±\"+_s(ip.stats.j/1000)+\"ms \")],2):_e()],2),(Object.keys(ip.tcp).length > 0)?_c(\"div\",{staticClass:\"ip-tcps\"},[_l((ip.tcp),function(tcp,port){return _c(\"div\",{key:port,staticClass:\"ip-tcp\",class:{'ip-error': tcp.e != null},attrs:{\"title\":tcp.e}},[_v(\" tcp/\"+_s(port)+\": \"+_s(tcp.e == null ? `${tcp.l/1000}ms` : tcp.k)+\" \")],2)})],2):_e()],2)})],2),_c(\"div\",{staticClass:\"https\"},[_l((host.http),function(res,url){return _c(\"div\",{key:url,staticClass:\"http\",attrs:{\"title\":_f(\"httpTitle\")(res)}},[_c(\"div\",{staticClass:\"http-url\"},[_v(_s(url))],2),_c(\"div\",{staticClass:\"http-status\",class:{'http-error': res.e != null}},[_v(\" \"+_s(res.e == null ? `${res.s} in ${res.l/1000}ms` : res.e)+\" \")],2)],2)})],2),_c(\"div\",{staticClass:\"certs\"},[_l((host.tls),function(cert,key){return _c(\"div\",{key:key,staticClass:\"cert\",attrs:{\"title\":_f(\"certTitle\")(cert)}},[_c(\"div\",{staticClass:\"cert-addr\"},[_v(_s(cert.a))],2),_c(\"div\",{staticClass:\"cert-status\",class:`cert-${cert.st}`},[_v(\" \"+_s(cert.e == null ? `certificate expires in ${cert.d} days` : cert.e)+\" \")],2)],2)})],2),(host.error)?_c(\"div\",{staticClass:\"host-error\"},[_v(_s(host.error))],2):_e(),_c(\"button\",{staticClass:\"probe\",attrs:{\"disabled\":probing != null},on:{\"click\":function($event){return probe({h: host.host})}}},[_v(\"re-probe\")],2)],2)})],2):_e(),(idx !== computedCategories.length - 1)?_c(\"hr\"):_e()],2)})],2)}");
+App.staticRenderFns=[];
+new Vue({render:function(h){return h(App)}}).$mount("#app")
+}});
@@ -1,8 +0,0 @@
-(window["webpackJsonp"]=window["webpackJsonp"]||[]).push([["chunk-vendors"],{"00b4":function(t,e,n){"use strict";n("ac1f");var r=n("23e7"),o=n("da84"),i=n("c65b"),a=n("e330"),c=n("1626"),s=n("861d"),u=function(){var t=!1,e=/[ac]/;return e.exec=function(){return t=!0,/./.exec.apply(this,arguments)},!0===e.test("abc")&&t}(),f=o.Error,l=a(/./.test);r({target:"RegExp",proto:!0,forced:!u},{test:function(t){var e=this.exec;if(!c(e))return l(this,t);var n=i(e,this,t);if(null!==n&&!s(n))throw new f("RegExp exec method returned something other than an Object or null");return!!n}})},"00ee":function(t,e,n){var r=n("b622"),o=r("toStringTag"),i={};i[o]="z",t.exports="[object z]"===String(i)},"01b4":function(t,e){var n=function(){this.head=null,this.tail=null};n.prototype={add:function(t){var e={item:t,next:null};this.head?this.tail.next=e:this.head=e,this.tail=e},get:function(){var t=this.head;if(t)return this.head=t.next,this.tail===t&&(this.tail=null),t.item}},t.exports=n},"0366":function(t,e,n){var r=n("e330"),o=n("59ed"),i=r(r.bind);t.exports=function(t,e){return o(t),void 0===e?t:i?i(t,e):function(){return t.apply(e,arguments)}}},"04d1":function(t,e,n){var r=n("342f"),o=r.match(/firefox\/(\d+)/i);t.exports=!!o&&+o[1]},"057f":function(t,e,n){var r=n("c6b6"),o=n("fc6a"),i=n("241c").f,a=n("4dae"),c="object"==typeof window&&window&&Object.getOwnPropertyNames?Object.getOwnPropertyNames(window):[],s=function(t){try{return i(t)}catch(e){return a(c)}};t.exports.f=function(t){return c&&"Window"==r(t)?s(t):i(o(t))}},"06c5":function(t,e,n){"use strict";n.d(e,"a",(function(){return o}));n("fb6a"),n("d3b7"),n("b0c0"),n("a630"),n("3ca3"),n("ac1f"),n("00b4");function r(t,e){(null==e||e>t.length)&&(e=t.length);for(var n=0,r=new Array(e);n<e;n++)r[n]=t[n];return r}function o(t,e){if(t){if("string"===typeof t)return r(t,e);var n=Object.prototype.toString.call(t).slice(8,-1);return"Object"===n&&t.constructor&&(n=t.constructor.name),"Map"===n||"Set"===n?Array.from(t):"Arguments"===n||/^(?:Ui|I)nt(?:8|16|32)(?:Clamped)?Array$/.test(n)?r(t,e):void 0}}},"06cf":function(t,e,n){var r=n("83ab"),o=n("c65b"),i=n("d1e7"),a=n("5c6c"),c=n("fc6a"),s=n("a04b"),u=n("1a2d"),f=n("0cfb"),l=Object.getOwnPropertyDescriptor;e.f=r?l:function(t,e){if(t=c(t),e=s(e),f)try{return l(t,e)}catch(n){}if(u(t,e))return a(!o(i.f,t,e),t[e])}},"07fa":function(t,e,n){var r=n("50c4");t.exports=function(t){return r(t.length)}},"0b42":function(t,e,n){var r=n("da84"),o=n("e8b5"),i=n("68ee"),a=n("861d"),c=n("b622"),s=c("species"),u=r.Array;t.exports=function(t){var e;return o(t)&&(e=t.constructor,i(e)&&(e===u||o(e.prototype))?e=void 0:a(e)&&(e=e[s],null===e&&(e=void 0))),void 0===e?u:e}},"0cfb":function(t,e,n){var r=n("83ab"),o=n("d039"),i=n("cc12");t.exports=!r&&!o((function(){return 7!=Object.defineProperty(i("div"),"a",{get:function(){return 7}}).a}))},"0d51":function(t,e,n){var r=n("da84"),o=r.String;t.exports=function(t){try{return o(t)}catch(e){return"Object"}}},"107c":function(t,e,n){var r=n("d039"),o=n("da84"),i=o.RegExp;t.exports=r((function(){var t=i("(?<a>b)","g");return"b"!==t.exec("b").groups.a||"bc"!=="b".replace(t,"$<a>c")}))},1276:function(t,e,n){"use strict";var r=n("2ba4"),o=n("c65b"),i=n("e330"),a=n("d784"),c=n("44e7"),s=n("825a"),u=n("1d80"),f=n("4840"),l=n("8aa5"),d=n("50c4"),p=n("577e"),v=n("dc4a"),h=n("4dae"),y=n("14c3"),m=n("9263"),g=n("9f7f"),b=n("d039"),_=g.UNSUPPORTED_Y,x=4294967295,w=Math.min,O=[].push,S=i(/./.exec),A=i(O),C=i("".slice),E=!b((function(){var t=/(?:)/,e=t.exec;t.exec=function(){return e.apply(this,arguments)};var n="ab".split(t);return 2!==n.length||"a"!==n[0]||"b"!==n[1]}));a("split",(function(t,e,n){var i;return i="c"=="abbc".split(/(b)*/)[1]||4!="test".split(/(?:)/,-1).length||2!="ab".split(/(?:ab)*/).length||4!=".".split(/(.?)(.?)/).length||".".split(/()()/).length>1||"".split(/.?/).length?function(t,n){var i=p(u(this)),a=void 0===n?x:n>>>0;if(0===a)return[];if(void 0===t)return[i];if(!c(t))return o(e,i,t,a);var s,f,l,d=[],v=(t.ignoreCase?"i":"")+(t.multiline?"m":"")+(t.unicode?"u":"")+(t.sticky?"y":""),y=0,g=new RegExp(t.source,v+"g");while(s=o(m,g,i)){if(f=g.lastIndex,f>y&&(A(d,C(i,y,s.index)),s.length>1&&s.index<i.length&&r(O,d,h(s,1)),l=s[0].length,y=f,d.length>=a))break;g.lastIndex===s.index&&g.lastIndex++}return y===i.length?!l&&S(g,"")||A(d,""):A(d,C(i,y)),d.length>a?h(d,0,a):d}:"0".split(void 0,0).length?function(t,n){return void 0===t&&0===n?[]:o(e,this,t,n)}:e,[function(e,n){var r=u(this),a=void 0==e?void 0:v(e,t);return a?o(a,e,r,n):o(i,p(r),e,n)},function(t,r){var o=s(this),a=p(t),c=n(i,o,a,r,i!==e);if(c.done)return c.value;var u=f(o,RegExp),v=o.unicode,h=(o.ignoreCase?"i":"")+(o.multiline?"m":"")+(o.unicode?"u":"")+(_?"g":"y"),m=new u(_?"^(?:"+o.source+")":o,h),g=void 0===r?x:r>>>0;if(0===g)return[];if(0===a.length)return null===y(m,a)?[a]:[];var b=0,O=0,S=[];while(O<a.length){m.lastIndex=_?0:O;var E,k=y(m,_?C(a,O):a);if(null===k||(E=w(d(m.lastIndex+(_?O:0)),a.length))===b)O=l(a,O,v);else{if(A(S,C(a,b,O)),S.length===g)return S;for(var j=1;j<=k.length-1;j++)if(A(S,k[j]),S.length===g)return S;O=b=E}}return A(S,C(a,b)),S}]}),!E,_)},"14c3":function(t,e,n){var r=n("da84"),o=n("c65b"),i=n("825a"),a=n("1626"),c=n("c6b6"),s=n("9263"),u=r.TypeError;t.exports=function(t,e){var n=t.exec;if(a(n)){var r=o(n,t,e);return null!==r&&i(r),r}if("RegExp"===c(t))return o(s,t,e);throw u("RegExp#exec called on incompatible receiver")}},1626:function(t,e){t.exports=function(t){return"function"==typeof t}},"19aa":function(t,e,n){var r=n("da84"),o=n("3a9b"),i=r.TypeError;t.exports=function(t,e){if(o(e,t))return t;throw i("Incorrect invocation")}},"1a2d":function(t,e,n){var r=n("e330"),o=n("7b0b"),i=r({}.hasOwnProperty);t.exports=Object.hasOwn||function(t,e){return i(o(t),e)}},"1be4":function(t,e,n){var r=n("d066");t.exports=r("document","documentElement")},"1c7e":function(t,e,n){var r=n("b622"),o=r("iterator"),i=!1;try{var a=0,c={next:function(){return{done:!!a++}},return:function(){i=!0}};c[o]=function(){return this},Array.from(c,(function(){throw 2}))}catch(s){}t.exports=function(t,e){if(!e&&!i)return!1;var n=!1;try{var r={};r[o]=function(){return{next:function(){return{done:n=!0}}}},t(r)}catch(s){}return n}},"1cdc":function(t,e,n){var r=n("342f");t.exports=/(?:ipad|iphone|ipod).*applewebkit/i.test(r)},"1d80":function(t,e,n){var r=n("da84"),o=r.TypeError;t.exports=function(t){if(void 0==t)throw o("Can't call method on "+t);return t}},"1da1":function(t,e,n){"use strict";n.d(e,"a",(function(){return o}));n("d3b7");function r(t,e,n,r,o,i,a){try{var c=t[i](a),s=c.value}catch(u){return void n(u)}c.done?e(s):Promise.resolve(s).then(r,o)}function o(t){return function(){var e=this,n=arguments;return new Promise((function(o,i){var a=t.apply(e,n);function c(t){r(a,o,i,c,s,"next",t)}function s(t){r(a,o,i,c,s,"throw",t)}c(void 0)}))}}},"1dde":function(t,e,n){var r=n("d039"),o=n("b622"),i=n("2d00"),a=o("species");t.exports=function(t){return i>=51||!r((function(){var e=[],n=e.constructor={};return n[a]=function(){return{foo:1}},1!==e[t](Boolean).foo}))}},2266:function(t,e,n){var r=n("da84"),o=n("0366"),i=n("c65b"),a=n("825a"),c=n("0d51"),s=n("e95a"),u=n("07fa"),f=n("3a9b"),l=n("9a1f"),d=n("35a1"),p=n("2a62"),v=r.TypeError,h=function(t,e){this.stopped=t,this.result=e},y=h.prototype;t.exports=function(t,e,n){var r,m,g,b,_,x,w,O=n&&n.that,S=!(!n||!n.AS_ENTRIES),A=!(!n||!n.IS_ITERATOR),C=!(!n||!n.INTERRUPTED),E=o(e,O),k=function(t){return r&&p(r,"normal",t),new h(!0,t)},j=function(t){return S?(a(t),C?E(t[0],t[1],k):E(t[0],t[1])):C?E(t,k):E(t)};if(A)r=t;else{if(m=d(t),!m)throw v(c(t)+" is not iterable");if(s(m)){for(g=0,b=u(t);b>g;g++)if(_=j(t[g]),_&&f(y,_))return _;return new h(!1)}r=l(t,m)}x=r.next;while(!(w=i(x,r)).done){try{_=j(w.value)}catch($){p(r,"throw",$)}if("object"==typeof _&&_&&f(y,_))return _}return new h(!1)}},"23cb":function(t,e,n){var r=n("5926"),o=Math.max,i=Math.min;t.exports=function(t,e){var n=r(t);return n<0?o(n+e,0):i(n,e)}},"23e7":function(t,e,n){var r=n("da84"),o=n("06cf").f,i=n("9112"),a=n("6eeb"),c=n("ce4e"),s=n("e893"),u=n("94ca");t.exports=function(t,e){var n,f,l,d,p,v,h=t.target,y=t.global,m=t.stat;if(f=y?r:m?r[h]||c(h,{}):(r[h]||{}).prototype,f)for(l in e){if(p=e[l],t.noTargetGet?(v=o(f,l),d=v&&v.value):d=f[l],n=u(y?l:h+(m?".":"#")+l,t.forced),!n&&void 0!==d){if(typeof p==typeof d)continue;s(p,d)}(t.sham||d&&d.sham)&&i(p,"sham",!0),a(f,l,p,t)}}},"241c":function(t,e,n){var r=n("ca84"),o=n("7839"),i=o.concat("length","prototype");e.f=Object.getOwnPropertyNames||function(t){return r(t,i)}},2626:function(t,e,n){"use strict";var r=n("d066"),o=n("9bf2"),i=n("b622"),a=n("83ab"),c=i("species");t.exports=function(t){var e=r(t),n=o.f;a&&e&&!e[c]&&n(e,c,{configurable:!0,get:function(){return this}})}},2877:function(t,e,n){"use strict";function r(t,e,n,r,o,i,a,c){var s,u="function"===typeof t?t.options:t;if(e&&(u.render=e,u.staticRenderFns=n,u._compiled=!0),r&&(u.functional=!0),i&&(u._scopeId="data-v-"+i),a?(s=function(t){t=t||this.$vnode&&this.$vnode.ssrContext||this.parent&&this.parent.$vnode&&this.parent.$vnode.ssrContext,t||"undefined"===typeof __VUE_SSR_CONTEXT__||(t=__VUE_SSR_CONTEXT__),o&&o.call(this,t),t&&t._registeredComponents&&t._registeredComponents.add(a)},u._ssrRegister=s):o&&(s=c?function(){o.call(this,(u.functional?this.parent:this).$root.$options.shadowRoot)}:o),s)if(u.functional){u._injectStyles=s;var f=u.render;u.render=function(t,e){return s.call(e),f(t,e)}}else{var l=u.beforeCreate;u.beforeCreate=l?[].concat(l,s):[s]}return{exports:t,options:u}}n.d(e,"a",(function(){return r}))},"2a62":function(t,e,n){var r=n("c65b"),o=n("825a"),i=n("dc4a");t.exports=function(t,e,n){var a,c;o(t);try{if(a=i(t,"return"),!a){if("throw"===e)throw n;return n}a=r(a,t)}catch(s){c=!0,a=s}if("throw"===e)throw n;if(c)throw a;return o(a),n}},"2b0e":function(t,e,n){"use strict";(function(t){
-/*!
- * Vue.js v2.6.14
- * (c) 2014-2021 Evan You
- * Released under the MIT License.
- */
-var n=Object.freeze({});function r(t){return void 0===t||null===t}function o(t){return void 0!==t&&null!==t}function i(t){return!0===t}function a(t){return!1===t}function c(t){return"string"===typeof t||"number"===typeof t||"symbol"===typeof t||"boolean"===typeof t}function s(t){return null!==t&&"object"===typeof t}var u=Object.prototype.toString;function f(t){return"[object Object]"===u.call(t)}function l(t){return"[object RegExp]"===u.call(t)}function d(t){var e=parseFloat(String(t));return e>=0&&Math.floor(e)===e&&isFinite(t)}function p(t){return o(t)&&"function"===typeof t.then&&"function"===typeof t.catch}function v(t){return null==t?"":Array.isArray(t)||f(t)&&t.toString===u?JSON.stringify(t,null,2):String(t)}function h(t){var e=parseFloat(t);return isNaN(e)?t:e}function y(t,e){for(var n=Object.create(null),r=t.split(","),o=0;o<r.length;o++)n[r[o]]=!0;return e?function(t){return n[t.toLowerCase()]}:function(t){return n[t]}}y("slot,component",!0);var m=y("key,ref,slot,slot-scope,is");function g(t,e){if(t.length){var n=t.indexOf(e);if(n>-1)return t.splice(n,1)}}var b=Object.prototype.hasOwnProperty;function _(t,e){return b.call(t,e)}function x(t){var e=Object.create(null);return function(n){var r=e[n];return r||(e[n]=t(n))}}var w=/-(\w)/g,O=x((function(t){return t.replace(w,(function(t,e){return e?e.toUpperCase():""}))})),S=x((function(t){return t.charAt(0).toUpperCase()+t.slice(1)})),A=/\B([A-Z])/g,C=x((function(t){return t.replace(A,"-$1").toLowerCase()}));function E(t,e){function n(n){var r=arguments.length;return r?r>1?t.apply(e,arguments):t.call(e,n):t.call(e)}return n._length=t.length,n}function k(t,e){return t.bind(e)}var j=Function.prototype.bind?k:E;function $(t,e){e=e||0;var n=t.length-e,r=new Array(n);while(n--)r[n]=t[n+e];return r}function T(t,e){for(var n in e)t[n]=e[n];return t}function I(t){for(var e={},n=0;n<t.length;n++)t[n]&&T(e,t[n]);return e}function P(t,e,n){}var L=function(t,e,n){return!1},N=function(t){return t};function D(t,e){if(t===e)return!0;var n=s(t),r=s(e);if(!n||!r)return!n&&!r&&String(t)===String(e);try{var o=Array.isArray(t),i=Array.isArray(e);if(o&&i)return t.length===e.length&&t.every((function(t,n){return D(t,e[n])}));if(t instanceof Date&&e instanceof Date)return t.getTime()===e.getTime();if(o||i)return!1;var a=Object.keys(t),c=Object.keys(e);return a.length===c.length&&a.every((function(n){return D(t[n],e[n])}))}catch(u){return!1}}function F(t,e){for(var n=0;n<t.length;n++)if(D(t[n],e))return n;return-1}function R(t){var e=!1;return function(){e||(e=!0,t.apply(this,arguments))}}var M="data-server-rendered",U=["component","directive","filter"],V=["beforeCreate","created","beforeMount","mounted","beforeUpdate","updated","beforeDestroy","destroyed","activated","deactivated","errorCaptured","serverPrefetch"],B={optionMergeStrategies:Object.create(null),silent:!1,productionTip:!1,devtools:!1,performance:!1,errorHandler:null,warnHandler:null,ignoredElements:[],keyCodes:Object.create(null),isReservedTag:L,isReservedAttr:L,isUnknownElement:L,getTagNamespace:P,parsePlatformTagName:N,mustUseProp:L,async:!0,_lifecycleHooks:V},G=/a-zA-Z\u00B7\u00C0-\u00D6\u00D8-\u00F6\u00F8-\u037D\u037F-\u1FFF\u200C-\u200D\u203F-\u2040\u2070-\u218F\u2C00-\u2FEF\u3001-\uD7FF\uF900-\uFDCF\uFDF0-\uFFFD/;function H(t){var e=(t+"").charCodeAt(0);return 36===e||95===e}function z(t,e,n,r){Object.defineProperty(t,e,{value:n,enumerable:!!r,writable:!0,configurable:!0})}var W=new RegExp("[^"+G.source+".$_\\d]");function K(t){if(!W.test(t)){var e=t.split(".");return function(t){for(var n=0;n<e.length;n++){if(!t)return;t=t[e[n]]}return t}}}var q,X="__proto__"in{},J="undefined"!==typeof window,Y="undefined"!==typeof WXEnvironment&&!!WXEnvironment.platform,Z=Y&&WXEnvironment.platform.toLowerCase(),Q=J&&window.navigator.userAgent.toLowerCase(),tt=Q&&/msie|trident/.test(Q),et=Q&&Q.indexOf("msie 9.0")>0,nt=Q&&Q.indexOf("edge/")>0,rt=(Q&&Q.indexOf("android"),Q&&/iphone|ipad|ipod|ios/.test(Q)||"ios"===Z),ot=(Q&&/chrome\/\d+/.test(Q),Q&&/phantomjs/.test(Q),Q&&Q.match(/firefox\/(\d+)/)),it={}.watch,at=!1;if(J)try{var ct={};Object.defineProperty(ct,"passive",{get:function(){at=!0}}),window.addEventListener("test-passive",null,ct)}catch(Sa){}var st=function(){return void 0===q&&(q=!J&&!Y&&"undefined"!==typeof t&&(t["process"]&&"server"===t["process"].env.VUE_ENV)),q},ut=J&&window.__VUE_DEVTOOLS_GLOBAL_HOOK__;function ft(t){return"function"===typeof t&&/native code/.test(t.toString())}var lt,dt="undefined"!==typeof Symbol&&ft(Symbol)&&"undefined"!==typeof Reflect&&ft(Reflect.ownKeys);lt="undefined"!==typeof Set&&ft(Set)?Set:function(){function t(){this.set=Object.create(null)}return t.prototype.has=function(t){return!0===this.set[t]},t.prototype.add=function(t){this.set[t]=!0},t.prototype.clear=function(){this.set=Object.create(null)},t}();var pt=P,vt=0,ht=function(){this.id=vt++,this.subs=[]};ht.prototype.addSub=function(t){this.subs.push(t)},ht.prototype.removeSub=function(t){g(this.subs,t)},ht.prototype.depend=function(){ht.target&&ht.target.addDep(this)},ht.prototype.notify=function(){var t=this.subs.slice();for(var e=0,n=t.length;e<n;e++)t[e].update()},ht.target=null;var yt=[];function mt(t){yt.push(t),ht.target=t}function gt(){yt.pop(),ht.target=yt[yt.length-1]}var bt=function(t,e,n,r,o,i,a,c){this.tag=t,this.data=e,this.children=n,this.text=r,this.elm=o,this.ns=void 0,this.context=i,this.fnContext=void 0,this.fnOptions=void 0,this.fnScopeId=void 0,this.key=e&&e.key,this.componentOptions=a,this.componentInstance=void 0,this.parent=void 0,this.raw=!1,this.isStatic=!1,this.isRootInsert=!0,this.isComment=!1,this.isCloned=!1,this.isOnce=!1,this.asyncFactory=c,this.asyncMeta=void 0,this.isAsyncPlaceholder=!1},_t={child:{configurable:!0}};_t.child.get=function(){return this.componentInstance},Object.defineProperties(bt.prototype,_t);var xt=function(t){void 0===t&&(t="");var e=new bt;return e.text=t,e.isComment=!0,e};function wt(t){return new bt(void 0,void 0,void 0,String(t))}function Ot(t){var e=new bt(t.tag,t.data,t.children&&t.children.slice(),t.text,t.elm,t.context,t.componentOptions,t.asyncFactory);return e.ns=t.ns,e.isStatic=t.isStatic,e.key=t.key,e.isComment=t.isComment,e.fnContext=t.fnContext,e.fnOptions=t.fnOptions,e.fnScopeId=t.fnScopeId,e.asyncMeta=t.asyncMeta,e.isCloned=!0,e}var St=Array.prototype,At=Object.create(St),Ct=["push","pop","shift","unshift","splice","sort","reverse"];Ct.forEach((function(t){var e=St[t];z(At,t,(function(){var n=[],r=arguments.length;while(r--)n[r]=arguments[r];var o,i=e.apply(this,n),a=this.__ob__;switch(t){case"push":case"unshift":o=n;break;case"splice":o=n.slice(2);break}return o&&a.observeArray(o),a.dep.notify(),i}))}));var Et=Object.getOwnPropertyNames(At),kt=!0;function jt(t){kt=t}var $t=function(t){this.value=t,this.dep=new ht,this.vmCount=0,z(t,"__ob__",this),Array.isArray(t)?(X?Tt(t,At):It(t,At,Et),this.observeArray(t)):this.walk(t)};function Tt(t,e){t.__proto__=e}function It(t,e,n){for(var r=0,o=n.length;r<o;r++){var i=n[r];z(t,i,e[i])}}function Pt(t,e){var n;if(s(t)&&!(t instanceof bt))return _(t,"__ob__")&&t.__ob__ instanceof $t?n=t.__ob__:kt&&!st()&&(Array.isArray(t)||f(t))&&Object.isExtensible(t)&&!t._isVue&&(n=new $t(t)),e&&n&&n.vmCount++,n}function Lt(t,e,n,r,o){var i=new ht,a=Object.getOwnPropertyDescriptor(t,e);if(!a||!1!==a.configurable){var c=a&&a.get,s=a&&a.set;c&&!s||2!==arguments.length||(n=t[e]);var u=!o&&Pt(n);Object.defineProperty(t,e,{enumerable:!0,configurable:!0,get:function(){var e=c?c.call(t):n;return ht.target&&(i.depend(),u&&(u.dep.depend(),Array.isArray(e)&&Ft(e))),e},set:function(e){var r=c?c.call(t):n;e===r||e!==e&&r!==r||c&&!s||(s?s.call(t,e):n=e,u=!o&&Pt(e),i.notify())}})}}function Nt(t,e,n){if(Array.isArray(t)&&d(e))return t.length=Math.max(t.length,e),t.splice(e,1,n),n;if(e in t&&!(e in Object.prototype))return t[e]=n,n;var r=t.__ob__;return t._isVue||r&&r.vmCount?n:r?(Lt(r.value,e,n),r.dep.notify(),n):(t[e]=n,n)}function Dt(t,e){if(Array.isArray(t)&&d(e))t.splice(e,1);else{var n=t.__ob__;t._isVue||n&&n.vmCount||_(t,e)&&(delete t[e],n&&n.dep.notify())}}function Ft(t){for(var e=void 0,n=0,r=t.length;n<r;n++)e=t[n],e&&e.__ob__&&e.__ob__.dep.depend(),Array.isArray(e)&&Ft(e)}$t.prototype.walk=function(t){for(var e=Object.keys(t),n=0;n<e.length;n++)Lt(t,e[n])},$t.prototype.observeArray=function(t){for(var e=0,n=t.length;e<n;e++)Pt(t[e])};var Rt=B.optionMergeStrategies;function Mt(t,e){if(!e)return t;for(var n,r,o,i=dt?Reflect.ownKeys(e):Object.keys(e),a=0;a<i.length;a++)n=i[a],"__ob__"!==n&&(r=t[n],o=e[n],_(t,n)?r!==o&&f(r)&&f(o)&&Mt(r,o):Nt(t,n,o));return t}function Ut(t,e,n){return n?function(){var r="function"===typeof e?e.call(n,n):e,o="function"===typeof t?t.call(n,n):t;return r?Mt(r,o):o}:e?t?function(){return Mt("function"===typeof e?e.call(this,this):e,"function"===typeof t?t.call(this,this):t)}:e:t}function Vt(t,e){var n=e?t?t.concat(e):Array.isArray(e)?e:[e]:t;return n?Bt(n):n}function Bt(t){for(var e=[],n=0;n<t.length;n++)-1===e.indexOf(t[n])&&e.push(t[n]);return e}function Gt(t,e,n,r){var o=Object.create(t||null);return e?T(o,e):o}Rt.data=function(t,e,n){return n?Ut(t,e,n):e&&"function"!==typeof e?t:Ut(t,e)},V.forEach((function(t){Rt[t]=Vt})),U.forEach((function(t){Rt[t+"s"]=Gt})),Rt.watch=function(t,e,n,r){if(t===it&&(t=void 0),e===it&&(e=void 0),!e)return Object.create(t||null);if(!t)return e;var o={};for(var i in T(o,t),e){var a=o[i],c=e[i];a&&!Array.isArray(a)&&(a=[a]),o[i]=a?a.concat(c):Array.isArray(c)?c:[c]}return o},Rt.props=Rt.methods=Rt.inject=Rt.computed=function(t,e,n,r){if(!t)return e;var o=Object.create(null);return T(o,t),e&&T(o,e),o},Rt.provide=Ut;var Ht=function(t,e){return void 0===e?t:e};function zt(t,e){var n=t.props;if(n){var r,o,i,a={};if(Array.isArray(n)){r=n.length;while(r--)o=n[r],"string"===typeof o&&(i=O(o),a[i]={type:null})}else if(f(n))for(var c in n)o=n[c],i=O(c),a[i]=f(o)?o:{type:o};else 0;t.props=a}}function Wt(t,e){var n=t.inject;if(n){var r=t.inject={};if(Array.isArray(n))for(var o=0;o<n.length;o++)r[n[o]]={from:n[o]};else if(f(n))for(var i in n){var a=n[i];r[i]=f(a)?T({from:i},a):{from:a}}else 0}}function Kt(t){var e=t.directives;if(e)for(var n in e){var r=e[n];"function"===typeof r&&(e[n]={bind:r,update:r})}}function qt(t,e,n){if("function"===typeof e&&(e=e.options),zt(e,n),Wt(e,n),Kt(e),!e._base&&(e.extends&&(t=qt(t,e.extends,n)),e.mixins))for(var r=0,o=e.mixins.length;r<o;r++)t=qt(t,e.mixins[r],n);var i,a={};for(i in t)c(i);for(i in e)_(t,i)||c(i);function c(r){var o=Rt[r]||Ht;a[r]=o(t[r],e[r],n,r)}return a}function Xt(t,e,n,r){if("string"===typeof n){var o=t[e];if(_(o,n))return o[n];var i=O(n);if(_(o,i))return o[i];var a=S(i);if(_(o,a))return o[a];var c=o[n]||o[i]||o[a];return c}}function Jt(t,e,n,r){var o=e[t],i=!_(n,t),a=n[t],c=ee(Boolean,o.type);if(c>-1)if(i&&!_(o,"default"))a=!1;else if(""===a||a===C(t)){var s=ee(String,o.type);(s<0||c<s)&&(a=!0)}if(void 0===a){a=Yt(r,o,t);var u=kt;jt(!0),Pt(a),jt(u)}return a}function Yt(t,e,n){if(_(e,"default")){var r=e.default;return t&&t.$options.propsData&&void 0===t.$options.propsData[n]&&void 0!==t._props[n]?t._props[n]:"function"===typeof r&&"Function"!==Qt(e.type)?r.call(t):r}}var Zt=/^\s*function (\w+)/;function Qt(t){var e=t&&t.toString().match(Zt);return e?e[1]:""}function te(t,e){return Qt(t)===Qt(e)}function ee(t,e){if(!Array.isArray(e))return te(e,t)?0:-1;for(var n=0,r=e.length;n<r;n++)if(te(e[n],t))return n;return-1}function ne(t,e,n){mt();try{if(e){var r=e;while(r=r.$parent){var o=r.$options.errorCaptured;if(o)for(var i=0;i<o.length;i++)try{var a=!1===o[i].call(r,t,e,n);if(a)return}catch(Sa){oe(Sa,r,"errorCaptured hook")}}}oe(t,e,n)}finally{gt()}}function re(t,e,n,r,o){var i;try{i=n?t.apply(e,n):t.call(e),i&&!i._isVue&&p(i)&&!i._handled&&(i.catch((function(t){return ne(t,r,o+" (Promise/async)")})),i._handled=!0)}catch(Sa){ne(Sa,r,o)}return i}function oe(t,e,n){if(B.errorHandler)try{return B.errorHandler.call(null,t,e,n)}catch(Sa){Sa!==t&&ie(Sa,null,"config.errorHandler")}ie(t,e,n)}function ie(t,e,n){if(!J&&!Y||"undefined"===typeof console)throw t;console.error(t)}var ae,ce=!1,se=[],ue=!1;function fe(){ue=!1;var t=se.slice(0);se.length=0;for(var e=0;e<t.length;e++)t[e]()}if("undefined"!==typeof Promise&&ft(Promise)){var le=Promise.resolve();ae=function(){le.then(fe),rt&&setTimeout(P)},ce=!0}else if(tt||"undefined"===typeof MutationObserver||!ft(MutationObserver)&&"[object MutationObserverConstructor]"!==MutationObserver.toString())ae="undefined"!==typeof setImmediate&&ft(setImmediate)?function(){setImmediate(fe)}:function(){setTimeout(fe,0)};else{var de=1,pe=new MutationObserver(fe),ve=document.createTextNode(String(de));pe.observe(ve,{characterData:!0}),ae=function(){de=(de+1)%2,ve.data=String(de)},ce=!0}function he(t,e){var n;if(se.push((function(){if(t)try{t.call(e)}catch(Sa){ne(Sa,e,"nextTick")}else n&&n(e)})),ue||(ue=!0,ae()),!t&&"undefined"!==typeof Promise)return new Promise((function(t){n=t}))}var ye=new lt;function me(t){ge(t,ye),ye.clear()}function ge(t,e){var n,r,o=Array.isArray(t);if(!(!o&&!s(t)||Object.isFrozen(t)||t instanceof bt)){if(t.__ob__){var i=t.__ob__.dep.id;if(e.has(i))return;e.add(i)}if(o){n=t.length;while(n--)ge(t[n],e)}else{r=Object.keys(t),n=r.length;while(n--)ge(t[r[n]],e)}}}var be=x((function(t){var e="&"===t.charAt(0);t=e?t.slice(1):t;var n="~"===t.charAt(0);t=n?t.slice(1):t;var r="!"===t.charAt(0);return t=r?t.slice(1):t,{name:t,once:n,capture:r,passive:e}}));function _e(t,e){function n(){var t=arguments,r=n.fns;if(!Array.isArray(r))return re(r,null,arguments,e,"v-on handler");for(var o=r.slice(),i=0;i<o.length;i++)re(o[i],null,t,e,"v-on handler")}return n.fns=t,n}function xe(t,e,n,o,a,c){var s,u,f,l;for(s in t)u=t[s],f=e[s],l=be(s),r(u)||(r(f)?(r(u.fns)&&(u=t[s]=_e(u,c)),i(l.once)&&(u=t[s]=a(l.name,u,l.capture)),n(l.name,u,l.capture,l.passive,l.params)):u!==f&&(f.fns=u,t[s]=f));for(s in e)r(t[s])&&(l=be(s),o(l.name,e[s],l.capture))}function we(t,e,n){var a;t instanceof bt&&(t=t.data.hook||(t.data.hook={}));var c=t[e];function s(){n.apply(this,arguments),g(a.fns,s)}r(c)?a=_e([s]):o(c.fns)&&i(c.merged)?(a=c,a.fns.push(s)):a=_e([c,s]),a.merged=!0,t[e]=a}function Oe(t,e,n){var i=e.options.props;if(!r(i)){var a={},c=t.attrs,s=t.props;if(o(c)||o(s))for(var u in i){var f=C(u);Se(a,s,u,f,!0)||Se(a,c,u,f,!1)}return a}}function Se(t,e,n,r,i){if(o(e)){if(_(e,n))return t[n]=e[n],i||delete e[n],!0;if(_(e,r))return t[n]=e[r],i||delete e[r],!0}return!1}function Ae(t){for(var e=0;e<t.length;e++)if(Array.isArray(t[e]))return Array.prototype.concat.apply([],t);return t}function Ce(t){return c(t)?[wt(t)]:Array.isArray(t)?ke(t):void 0}function Ee(t){return o(t)&&o(t.text)&&a(t.isComment)}function ke(t,e){var n,a,s,u,f=[];for(n=0;n<t.length;n++)a=t[n],r(a)||"boolean"===typeof a||(s=f.length-1,u=f[s],Array.isArray(a)?a.length>0&&(a=ke(a,(e||"")+"_"+n),Ee(a[0])&&Ee(u)&&(f[s]=wt(u.text+a[0].text),a.shift()),f.push.apply(f,a)):c(a)?Ee(u)?f[s]=wt(u.text+a):""!==a&&f.push(wt(a)):Ee(a)&&Ee(u)?f[s]=wt(u.text+a.text):(i(t._isVList)&&o(a.tag)&&r(a.key)&&o(e)&&(a.key="__vlist"+e+"_"+n+"__"),f.push(a)));return f}function je(t){var e=t.$options.provide;e&&(t._provided="function"===typeof e?e.call(t):e)}function $e(t){var e=Te(t.$options.inject,t);e&&(jt(!1),Object.keys(e).forEach((function(n){Lt(t,n,e[n])})),jt(!0))}function Te(t,e){if(t){for(var n=Object.create(null),r=dt?Reflect.ownKeys(t):Object.keys(t),o=0;o<r.length;o++){var i=r[o];if("__ob__"!==i){var a=t[i].from,c=e;while(c){if(c._provided&&_(c._provided,a)){n[i]=c._provided[a];break}c=c.$parent}if(!c)if("default"in t[i]){var s=t[i].default;n[i]="function"===typeof s?s.call(e):s}else 0}}return n}}function Ie(t,e){if(!t||!t.length)return{};for(var n={},r=0,o=t.length;r<o;r++){var i=t[r],a=i.data;if(a&&a.attrs&&a.attrs.slot&&delete a.attrs.slot,i.context!==e&&i.fnContext!==e||!a||null==a.slot)(n.default||(n.default=[])).push(i);else{var c=a.slot,s=n[c]||(n[c]=[]);"template"===i.tag?s.push.apply(s,i.children||[]):s.push(i)}}for(var u in n)n[u].every(Pe)&&delete n[u];return n}function Pe(t){return t.isComment&&!t.asyncFactory||" "===t.text}function Le(t){return t.isComment&&t.asyncFactory}function Ne(t,e,r){var o,i=Object.keys(e).length>0,a=t?!!t.$stable:!i,c=t&&t.$key;if(t){if(t._normalized)return t._normalized;if(a&&r&&r!==n&&c===r.$key&&!i&&!r.$hasNormal)return r;for(var s in o={},t)t[s]&&"$"!==s[0]&&(o[s]=De(e,s,t[s]))}else o={};for(var u in e)u in o||(o[u]=Fe(e,u));return t&&Object.isExtensible(t)&&(t._normalized=o),z(o,"$stable",a),z(o,"$key",c),z(o,"$hasNormal",i),o}function De(t,e,n){var r=function(){var t=arguments.length?n.apply(null,arguments):n({});t=t&&"object"===typeof t&&!Array.isArray(t)?[t]:Ce(t);var e=t&&t[0];return t&&(!e||1===t.length&&e.isComment&&!Le(e))?void 0:t};return n.proxy&&Object.defineProperty(t,e,{get:r,enumerable:!0,configurable:!0}),r}function Fe(t,e){return function(){return t[e]}}function Re(t,e){var n,r,i,a,c;if(Array.isArray(t)||"string"===typeof t)for(n=new Array(t.length),r=0,i=t.length;r<i;r++)n[r]=e(t[r],r);else if("number"===typeof t)for(n=new Array(t),r=0;r<t;r++)n[r]=e(r+1,r);else if(s(t))if(dt&&t[Symbol.iterator]){n=[];var u=t[Symbol.iterator](),f=u.next();while(!f.done)n.push(e(f.value,n.length)),f=u.next()}else for(a=Object.keys(t),n=new Array(a.length),r=0,i=a.length;r<i;r++)c=a[r],n[r]=e(t[c],c,r);return o(n)||(n=[]),n._isVList=!0,n}function Me(t,e,n,r){var o,i=this.$scopedSlots[t];i?(n=n||{},r&&(n=T(T({},r),n)),o=i(n)||("function"===typeof e?e():e)):o=this.$slots[t]||("function"===typeof e?e():e);var a=n&&n.slot;return a?this.$createElement("template",{slot:a},o):o}function Ue(t){return Xt(this.$options,"filters",t,!0)||N}function Ve(t,e){return Array.isArray(t)?-1===t.indexOf(e):t!==e}function Be(t,e,n,r,o){var i=B.keyCodes[e]||n;return o&&r&&!B.keyCodes[e]?Ve(o,r):i?Ve(i,t):r?C(r)!==e:void 0===t}function Ge(t,e,n,r,o){if(n)if(s(n)){var i;Array.isArray(n)&&(n=I(n));var a=function(a){if("class"===a||"style"===a||m(a))i=t;else{var c=t.attrs&&t.attrs.type;i=r||B.mustUseProp(e,c,a)?t.domProps||(t.domProps={}):t.attrs||(t.attrs={})}var s=O(a),u=C(a);if(!(s in i)&&!(u in i)&&(i[a]=n[a],o)){var f=t.on||(t.on={});f["update:"+a]=function(t){n[a]=t}}};for(var c in n)a(c)}else;return t}function He(t,e){var n=this._staticTrees||(this._staticTrees=[]),r=n[t];return r&&!e||(r=n[t]=this.$options.staticRenderFns[t].call(this._renderProxy,null,this),We(r,"__static__"+t,!1)),r}function ze(t,e,n){return We(t,"__once__"+e+(n?"_"+n:""),!0),t}function We(t,e,n){if(Array.isArray(t))for(var r=0;r<t.length;r++)t[r]&&"string"!==typeof t[r]&&Ke(t[r],e+"_"+r,n);else Ke(t,e,n)}function Ke(t,e,n){t.isStatic=!0,t.key=e,t.isOnce=n}function qe(t,e){if(e)if(f(e)){var n=t.on=t.on?T({},t.on):{};for(var r in e){var o=n[r],i=e[r];n[r]=o?[].concat(o,i):i}}else;return t}function Xe(t,e,n,r){e=e||{$stable:!n};for(var o=0;o<t.length;o++){var i=t[o];Array.isArray(i)?Xe(i,e,n):i&&(i.proxy&&(i.fn.proxy=!0),e[i.key]=i.fn)}return r&&(e.$key=r),e}function Je(t,e){for(var n=0;n<e.length;n+=2){var r=e[n];"string"===typeof r&&r&&(t[e[n]]=e[n+1])}return t}function Ye(t,e){return"string"===typeof t?e+t:t}function Ze(t){t._o=ze,t._n=h,t._s=v,t._l=Re,t._t=Me,t._q=D,t._i=F,t._m=He,t._f=Ue,t._k=Be,t._b=Ge,t._v=wt,t._e=xt,t._u=Xe,t._g=qe,t._d=Je,t._p=Ye}function Qe(t,e,r,o,a){var c,s=this,u=a.options;_(o,"_uid")?(c=Object.create(o),c._original=o):(c=o,o=o._original);var f=i(u._compiled),l=!f;this.data=t,this.props=e,this.children=r,this.parent=o,this.listeners=t.on||n,this.injections=Te(u.inject,o),this.slots=function(){return s.$slots||Ne(t.scopedSlots,s.$slots=Ie(r,o)),s.$slots},Object.defineProperty(this,"scopedSlots",{enumerable:!0,get:function(){return Ne(t.scopedSlots,this.slots())}}),f&&(this.$options=u,this.$slots=this.slots(),this.$scopedSlots=Ne(t.scopedSlots,this.$slots)),u._scopeId?this._c=function(t,e,n,r){var i=pn(c,t,e,n,r,l);return i&&!Array.isArray(i)&&(i.fnScopeId=u._scopeId,i.fnContext=o),i}:this._c=function(t,e,n,r){return pn(c,t,e,n,r,l)}}function tn(t,e,r,i,a){var c=t.options,s={},u=c.props;if(o(u))for(var f in u)s[f]=Jt(f,u,e||n);else o(r.attrs)&&nn(s,r.attrs),o(r.props)&&nn(s,r.props);var l=new Qe(r,s,a,i,t),d=c.render.call(null,l._c,l);if(d instanceof bt)return en(d,r,l.parent,c,l);if(Array.isArray(d)){for(var p=Ce(d)||[],v=new Array(p.length),h=0;h<p.length;h++)v[h]=en(p[h],r,l.parent,c,l);return v}}function en(t,e,n,r,o){var i=Ot(t);return i.fnContext=n,i.fnOptions=r,e.slot&&((i.data||(i.data={})).slot=e.slot),i}function nn(t,e){for(var n in e)t[O(n)]=e[n]}Ze(Qe.prototype);var rn={init:function(t,e){if(t.componentInstance&&!t.componentInstance._isDestroyed&&t.data.keepAlive){var n=t;rn.prepatch(n,n)}else{var r=t.componentInstance=cn(t,Tn);r.$mount(e?t.elm:void 0,e)}},prepatch:function(t,e){var n=e.componentOptions,r=e.componentInstance=t.componentInstance;Dn(r,n.propsData,n.listeners,e,n.children)},insert:function(t){var e=t.context,n=t.componentInstance;n._isMounted||(n._isMounted=!0,Un(n,"mounted")),t.data.keepAlive&&(e._isMounted?Qn(n):Rn(n,!0))},destroy:function(t){var e=t.componentInstance;e._isDestroyed||(t.data.keepAlive?Mn(e,!0):e.$destroy())}},on=Object.keys(rn);function an(t,e,n,a,c){if(!r(t)){var u=n.$options._base;if(s(t)&&(t=u.extend(t)),"function"===typeof t){var f;if(r(t.cid)&&(f=t,t=On(f,u),void 0===t))return wn(f,e,n,a,c);e=e||{},wr(t),o(e.model)&&fn(t.options,e);var l=Oe(e,t,c);if(i(t.options.functional))return tn(t,l,e,n,a);var d=e.on;if(e.on=e.nativeOn,i(t.options.abstract)){var p=e.slot;e={},p&&(e.slot=p)}sn(e);var v=t.options.name||c,h=new bt("vue-component-"+t.cid+(v?"-"+v:""),e,void 0,void 0,void 0,n,{Ctor:t,propsData:l,listeners:d,tag:c,children:a},f);return h}}}function cn(t,e){var n={_isComponent:!0,_parentVnode:t,parent:e},r=t.data.inlineTemplate;return o(r)&&(n.render=r.render,n.staticRenderFns=r.staticRenderFns),new t.componentOptions.Ctor(n)}function sn(t){for(var e=t.hook||(t.hook={}),n=0;n<on.length;n++){var r=on[n],o=e[r],i=rn[r];o===i||o&&o._merged||(e[r]=o?un(i,o):i)}}function un(t,e){var n=function(n,r){t(n,r),e(n,r)};return n._merged=!0,n}function fn(t,e){var n=t.model&&t.model.prop||"value",r=t.model&&t.model.event||"input";(e.attrs||(e.attrs={}))[n]=e.model.value;var i=e.on||(e.on={}),a=i[r],c=e.model.callback;o(a)?(Array.isArray(a)?-1===a.indexOf(c):a!==c)&&(i[r]=[c].concat(a)):i[r]=c}var ln=1,dn=2;function pn(t,e,n,r,o,a){return(Array.isArray(n)||c(n))&&(o=r,r=n,n=void 0),i(a)&&(o=dn),vn(t,e,n,r,o)}function vn(t,e,n,r,i){if(o(n)&&o(n.__ob__))return xt();if(o(n)&&o(n.is)&&(e=n.is),!e)return xt();var a,c,s;(Array.isArray(r)&&"function"===typeof r[0]&&(n=n||{},n.scopedSlots={default:r[0]},r.length=0),i===dn?r=Ce(r):i===ln&&(r=Ae(r)),"string"===typeof e)?(c=t.$vnode&&t.$vnode.ns||B.getTagNamespace(e),a=B.isReservedTag(e)?new bt(B.parsePlatformTagName(e),n,r,void 0,void 0,t):n&&n.pre||!o(s=Xt(t.$options,"components",e))?new bt(e,n,r,void 0,void 0,t):an(s,n,t,r,e)):a=an(e,n,t,r);return Array.isArray(a)?a:o(a)?(o(c)&&hn(a,c),o(n)&&yn(n),a):xt()}function hn(t,e,n){if(t.ns=e,"foreignObject"===t.tag&&(e=void 0,n=!0),o(t.children))for(var a=0,c=t.children.length;a<c;a++){var s=t.children[a];o(s.tag)&&(r(s.ns)||i(n)&&"svg"!==s.tag)&&hn(s,e,n)}}function yn(t){s(t.style)&&me(t.style),s(t.class)&&me(t.class)}function mn(t){t._vnode=null,t._staticTrees=null;var e=t.$options,r=t.$vnode=e._parentVnode,o=r&&r.context;t.$slots=Ie(e._renderChildren,o),t.$scopedSlots=n,t._c=function(e,n,r,o){return pn(t,e,n,r,o,!1)},t.$createElement=function(e,n,r,o){return pn(t,e,n,r,o,!0)};var i=r&&r.data;Lt(t,"$attrs",i&&i.attrs||n,null,!0),Lt(t,"$listeners",e._parentListeners||n,null,!0)}var gn,bn=null;function _n(t){Ze(t.prototype),t.prototype.$nextTick=function(t){return he(t,this)},t.prototype._render=function(){var t,e=this,n=e.$options,r=n.render,o=n._parentVnode;o&&(e.$scopedSlots=Ne(o.data.scopedSlots,e.$slots,e.$scopedSlots)),e.$vnode=o;try{bn=e,t=r.call(e._renderProxy,e.$createElement)}catch(Sa){ne(Sa,e,"render"),t=e._vnode}finally{bn=null}return Array.isArray(t)&&1===t.length&&(t=t[0]),t instanceof bt||(t=xt()),t.parent=o,t}}function xn(t,e){return(t.__esModule||dt&&"Module"===t[Symbol.toStringTag])&&(t=t.default),s(t)?e.extend(t):t}function wn(t,e,n,r,o){var i=xt();return i.asyncFactory=t,i.asyncMeta={data:e,context:n,children:r,tag:o},i}function On(t,e){if(i(t.error)&&o(t.errorComp))return t.errorComp;if(o(t.resolved))return t.resolved;var n=bn;if(n&&o(t.owners)&&-1===t.owners.indexOf(n)&&t.owners.push(n),i(t.loading)&&o(t.loadingComp))return t.loadingComp;if(n&&!o(t.owners)){var a=t.owners=[n],c=!0,u=null,f=null;n.$on("hook:destroyed",(function(){return g(a,n)}));var l=function(t){for(var e=0,n=a.length;e<n;e++)a[e].$forceUpdate();t&&(a.length=0,null!==u&&(clearTimeout(u),u=null),null!==f&&(clearTimeout(f),f=null))},d=R((function(n){t.resolved=xn(n,e),c?a.length=0:l(!0)})),v=R((function(e){o(t.errorComp)&&(t.error=!0,l(!0))})),h=t(d,v);return s(h)&&(p(h)?r(t.resolved)&&h.then(d,v):p(h.component)&&(h.component.then(d,v),o(h.error)&&(t.errorComp=xn(h.error,e)),o(h.loading)&&(t.loadingComp=xn(h.loading,e),0===h.delay?t.loading=!0:u=setTimeout((function(){u=null,r(t.resolved)&&r(t.error)&&(t.loading=!0,l(!1))}),h.delay||200)),o(h.timeout)&&(f=setTimeout((function(){f=null,r(t.resolved)&&v(null)}),h.timeout)))),c=!1,t.loading?t.loadingComp:t.resolved}}function Sn(t){if(Array.isArray(t))for(var e=0;e<t.length;e++){var n=t[e];if(o(n)&&(o(n.componentOptions)||Le(n)))return n}}function An(t){t._events=Object.create(null),t._hasHookEvent=!1;var e=t.$options._parentListeners;e&&jn(t,e)}function Cn(t,e){gn.$on(t,e)}function En(t,e){gn.$off(t,e)}function kn(t,e){var n=gn;return function r(){var o=e.apply(null,arguments);null!==o&&n.$off(t,r)}}function jn(t,e,n){gn=t,xe(e,n||{},Cn,En,kn,t),gn=void 0}function $n(t){var e=/^hook:/;t.prototype.$on=function(t,n){var r=this;if(Array.isArray(t))for(var o=0,i=t.length;o<i;o++)r.$on(t[o],n);else(r._events[t]||(r._events[t]=[])).push(n),e.test(t)&&(r._hasHookEvent=!0);return r},t.prototype.$once=function(t,e){var n=this;function r(){n.$off(t,r),e.apply(n,arguments)}return r.fn=e,n.$on(t,r),n},t.prototype.$off=function(t,e){var n=this;if(!arguments.length)return n._events=Object.create(null),n;if(Array.isArray(t)){for(var r=0,o=t.length;r<o;r++)n.$off(t[r],e);return n}var i,a=n._events[t];if(!a)return n;if(!e)return n._events[t]=null,n;var c=a.length;while(c--)if(i=a[c],i===e||i.fn===e){a.splice(c,1);break}return n},t.prototype.$emit=function(t){var e=this,n=e._events[t];if(n){n=n.length>1?$(n):n;for(var r=$(arguments,1),o='event handler for "'+t+'"',i=0,a=n.length;i<a;i++)re(n[i],e,r,e,o)}return e}}var Tn=null;function In(t){var e=Tn;return Tn=t,function(){Tn=e}}function Pn(t){var e=t.$options,n=e.parent;if(n&&!e.abstract){while(n.$options.abstract&&n.$parent)n=n.$parent;n.$children.push(t)}t.$parent=n,t.$root=n?n.$root:t,t.$children=[],t.$refs={},t._watcher=null,t._inactive=null,t._directInactive=!1,t._isMounted=!1,t._isDestroyed=!1,t._isBeingDestroyed=!1}function Ln(t){t.prototype._update=function(t,e){var n=this,r=n.$el,o=n._vnode,i=In(n);n._vnode=t,n.$el=o?n.__patch__(o,t):n.__patch__(n.$el,t,e,!1),i(),r&&(r.__vue__=null),n.$el&&(n.$el.__vue__=n),n.$vnode&&n.$parent&&n.$vnode===n.$parent._vnode&&(n.$parent.$el=n.$el)},t.prototype.$forceUpdate=function(){var t=this;t._watcher&&t._watcher.update()},t.prototype.$destroy=function(){var t=this;if(!t._isBeingDestroyed){Un(t,"beforeDestroy"),t._isBeingDestroyed=!0;var e=t.$parent;!e||e._isBeingDestroyed||t.$options.abstract||g(e.$children,t),t._watcher&&t._watcher.teardown();var n=t._watchers.length;while(n--)t._watchers[n].teardown();t._data.__ob__&&t._data.__ob__.vmCount--,t._isDestroyed=!0,t.__patch__(t._vnode,null),Un(t,"destroyed"),t.$off(),t.$el&&(t.$el.__vue__=null),t.$vnode&&(t.$vnode.parent=null)}}}function Nn(t,e,n){var r;return t.$el=e,t.$options.render||(t.$options.render=xt),Un(t,"beforeMount"),r=function(){t._update(t._render(),n)},new rr(t,r,P,{before:function(){t._isMounted&&!t._isDestroyed&&Un(t,"beforeUpdate")}},!0),n=!1,null==t.$vnode&&(t._isMounted=!0,Un(t,"mounted")),t}function Dn(t,e,r,o,i){var a=o.data.scopedSlots,c=t.$scopedSlots,s=!!(a&&!a.$stable||c!==n&&!c.$stable||a&&t.$scopedSlots.$key!==a.$key||!a&&t.$scopedSlots.$key),u=!!(i||t.$options._renderChildren||s);if(t.$options._parentVnode=o,t.$vnode=o,t._vnode&&(t._vnode.parent=o),t.$options._renderChildren=i,t.$attrs=o.data.attrs||n,t.$listeners=r||n,e&&t.$options.props){jt(!1);for(var f=t._props,l=t.$options._propKeys||[],d=0;d<l.length;d++){var p=l[d],v=t.$options.props;f[p]=Jt(p,v,e,t)}jt(!0),t.$options.propsData=e}r=r||n;var h=t.$options._parentListeners;t.$options._parentListeners=r,jn(t,r,h),u&&(t.$slots=Ie(i,o.context),t.$forceUpdate())}function Fn(t){while(t&&(t=t.$parent))if(t._inactive)return!0;return!1}function Rn(t,e){if(e){if(t._directInactive=!1,Fn(t))return}else if(t._directInactive)return;if(t._inactive||null===t._inactive){t._inactive=!1;for(var n=0;n<t.$children.length;n++)Rn(t.$children[n]);Un(t,"activated")}}function Mn(t,e){if((!e||(t._directInactive=!0,!Fn(t)))&&!t._inactive){t._inactive=!0;for(var n=0;n<t.$children.length;n++)Mn(t.$children[n]);Un(t,"deactivated")}}function Un(t,e){mt();var n=t.$options[e],r=e+" hook";if(n)for(var o=0,i=n.length;o<i;o++)re(n[o],t,null,t,r);t._hasHookEvent&&t.$emit("hook:"+e),gt()}var Vn=[],Bn=[],Gn={},Hn=!1,zn=!1,Wn=0;function Kn(){Wn=Vn.length=Bn.length=0,Gn={},Hn=zn=!1}var qn=0,Xn=Date.now;if(J&&!tt){var Jn=window.performance;Jn&&"function"===typeof Jn.now&&Xn()>document.createEvent("Event").timeStamp&&(Xn=function(){return Jn.now()})}function Yn(){var t,e;for(qn=Xn(),zn=!0,Vn.sort((function(t,e){return t.id-e.id})),Wn=0;Wn<Vn.length;Wn++)t=Vn[Wn],t.before&&t.before(),e=t.id,Gn[e]=null,t.run();var n=Bn.slice(),r=Vn.slice();Kn(),tr(n),Zn(r),ut&&B.devtools&&ut.emit("flush")}function Zn(t){var e=t.length;while(e--){var n=t[e],r=n.vm;r._watcher===n&&r._isMounted&&!r._isDestroyed&&Un(r,"updated")}}function Qn(t){t._inactive=!1,Bn.push(t)}function tr(t){for(var e=0;e<t.length;e++)t[e]._inactive=!0,Rn(t[e],!0)}function er(t){var e=t.id;if(null==Gn[e]){if(Gn[e]=!0,zn){var n=Vn.length-1;while(n>Wn&&Vn[n].id>t.id)n--;Vn.splice(n+1,0,t)}else Vn.push(t);Hn||(Hn=!0,he(Yn))}}var nr=0,rr=function(t,e,n,r,o){this.vm=t,o&&(t._watcher=this),t._watchers.push(this),r?(this.deep=!!r.deep,this.user=!!r.user,this.lazy=!!r.lazy,this.sync=!!r.sync,this.before=r.before):this.deep=this.user=this.lazy=this.sync=!1,this.cb=n,this.id=++nr,this.active=!0,this.dirty=this.lazy,this.deps=[],this.newDeps=[],this.depIds=new lt,this.newDepIds=new lt,this.expression="","function"===typeof e?this.getter=e:(this.getter=K(e),this.getter||(this.getter=P)),this.value=this.lazy?void 0:this.get()};rr.prototype.get=function(){var t;mt(this);var e=this.vm;try{t=this.getter.call(e,e)}catch(Sa){if(!this.user)throw Sa;ne(Sa,e,'getter for watcher "'+this.expression+'"')}finally{this.deep&&me(t),gt(),this.cleanupDeps()}return t},rr.prototype.addDep=function(t){var e=t.id;this.newDepIds.has(e)||(this.newDepIds.add(e),this.newDeps.push(t),this.depIds.has(e)||t.addSub(this))},rr.prototype.cleanupDeps=function(){var t=this.deps.length;while(t--){var e=this.deps[t];this.newDepIds.has(e.id)||e.removeSub(this)}var n=this.depIds;this.depIds=this.newDepIds,this.newDepIds=n,this.newDepIds.clear(),n=this.deps,this.deps=this.newDeps,this.newDeps=n,this.newDeps.length=0},rr.prototype.update=function(){this.lazy?this.dirty=!0:this.sync?this.run():er(this)},rr.prototype.run=function(){if(this.active){var t=this.get();if(t!==this.value||s(t)||this.deep){var e=this.value;if(this.value=t,this.user){var n='callback for watcher "'+this.expression+'"';re(this.cb,this.vm,[t,e],this.vm,n)}else this.cb.call(this.vm,t,e)}}},rr.prototype.evaluate=function(){this.value=this.get(),this.dirty=!1},rr.prototype.depend=function(){var t=this.deps.length;while(t--)this.deps[t].depend()},rr.prototype.teardown=function(){if(this.active){this.vm._isBeingDestroyed||g(this.vm._watchers,this);var t=this.deps.length;while(t--)this.deps[t].removeSub(this);this.active=!1}};var or={enumerable:!0,configurable:!0,get:P,set:P};function ir(t,e,n){or.get=function(){return this[e][n]},or.set=function(t){this[e][n]=t},Object.defineProperty(t,n,or)}function ar(t){t._watchers=[];var e=t.$options;e.props&&cr(t,e.props),e.methods&&hr(t,e.methods),e.data?sr(t):Pt(t._data={},!0),e.computed&&lr(t,e.computed),e.watch&&e.watch!==it&&yr(t,e.watch)}function cr(t,e){var n=t.$options.propsData||{},r=t._props={},o=t.$options._propKeys=[],i=!t.$parent;i||jt(!1);var a=function(i){o.push(i);var a=Jt(i,e,n,t);Lt(r,i,a),i in t||ir(t,"_props",i)};for(var c in e)a(c);jt(!0)}function sr(t){var e=t.$options.data;e=t._data="function"===typeof e?ur(e,t):e||{},f(e)||(e={});var n=Object.keys(e),r=t.$options.props,o=(t.$options.methods,n.length);while(o--){var i=n[o];0,r&&_(r,i)||H(i)||ir(t,"_data",i)}Pt(e,!0)}function ur(t,e){mt();try{return t.call(e,e)}catch(Sa){return ne(Sa,e,"data()"),{}}finally{gt()}}var fr={lazy:!0};function lr(t,e){var n=t._computedWatchers=Object.create(null),r=st();for(var o in e){var i=e[o],a="function"===typeof i?i:i.get;0,r||(n[o]=new rr(t,a||P,P,fr)),o in t||dr(t,o,i)}}function dr(t,e,n){var r=!st();"function"===typeof n?(or.get=r?pr(e):vr(n),or.set=P):(or.get=n.get?r&&!1!==n.cache?pr(e):vr(n.get):P,or.set=n.set||P),Object.defineProperty(t,e,or)}function pr(t){return function(){var e=this._computedWatchers&&this._computedWatchers[t];if(e)return e.dirty&&e.evaluate(),ht.target&&e.depend(),e.value}}function vr(t){return function(){return t.call(this,this)}}function hr(t,e){t.$options.props;for(var n in e)t[n]="function"!==typeof e[n]?P:j(e[n],t)}function yr(t,e){for(var n in e){var r=e[n];if(Array.isArray(r))for(var o=0;o<r.length;o++)mr(t,n,r[o]);else mr(t,n,r)}}function mr(t,e,n,r){return f(n)&&(r=n,n=n.handler),"string"===typeof n&&(n=t[n]),t.$watch(e,n,r)}function gr(t){var e={get:function(){return this._data}},n={get:function(){return this._props}};Object.defineProperty(t.prototype,"$data",e),Object.defineProperty(t.prototype,"$props",n),t.prototype.$set=Nt,t.prototype.$delete=Dt,t.prototype.$watch=function(t,e,n){var r=this;if(f(e))return mr(r,t,e,n);n=n||{},n.user=!0;var o=new rr(r,t,e,n);if(n.immediate){var i='callback for immediate watcher "'+o.expression+'"';mt(),re(e,r,[o.value],r,i),gt()}return function(){o.teardown()}}}var br=0;function _r(t){t.prototype._init=function(t){var e=this;e._uid=br++,e._isVue=!0,t&&t._isComponent?xr(e,t):e.$options=qt(wr(e.constructor),t||{},e),e._renderProxy=e,e._self=e,Pn(e),An(e),mn(e),Un(e,"beforeCreate"),$e(e),ar(e),je(e),Un(e,"created"),e.$options.el&&e.$mount(e.$options.el)}}function xr(t,e){var n=t.$options=Object.create(t.constructor.options),r=e._parentVnode;n.parent=e.parent,n._parentVnode=r;var o=r.componentOptions;n.propsData=o.propsData,n._parentListeners=o.listeners,n._renderChildren=o.children,n._componentTag=o.tag,e.render&&(n.render=e.render,n.staticRenderFns=e.staticRenderFns)}function wr(t){var e=t.options;if(t.super){var n=wr(t.super),r=t.superOptions;if(n!==r){t.superOptions=n;var o=Or(t);o&&T(t.extendOptions,o),e=t.options=qt(n,t.extendOptions),e.name&&(e.components[e.name]=t)}}return e}function Or(t){var e,n=t.options,r=t.sealedOptions;for(var o in n)n[o]!==r[o]&&(e||(e={}),e[o]=n[o]);return e}function Sr(t){this._init(t)}function Ar(t){t.use=function(t){var e=this._installedPlugins||(this._installedPlugins=[]);if(e.indexOf(t)>-1)return this;var n=$(arguments,1);return n.unshift(this),"function"===typeof t.install?t.install.apply(t,n):"function"===typeof t&&t.apply(null,n),e.push(t),this}}function Cr(t){t.mixin=function(t){return this.options=qt(this.options,t),this}}function Er(t){t.cid=0;var e=1;t.extend=function(t){t=t||{};var n=this,r=n.cid,o=t._Ctor||(t._Ctor={});if(o[r])return o[r];var i=t.name||n.options.name;var a=function(t){this._init(t)};return a.prototype=Object.create(n.prototype),a.prototype.constructor=a,a.cid=e++,a.options=qt(n.options,t),a["super"]=n,a.options.props&&kr(a),a.options.computed&&jr(a),a.extend=n.extend,a.mixin=n.mixin,a.use=n.use,U.forEach((function(t){a[t]=n[t]})),i&&(a.options.components[i]=a),a.superOptions=n.options,a.extendOptions=t,a.sealedOptions=T({},a.options),o[r]=a,a}}function kr(t){var e=t.options.props;for(var n in e)ir(t.prototype,"_props",n)}function jr(t){var e=t.options.computed;for(var n in e)dr(t.prototype,n,e[n])}function $r(t){U.forEach((function(e){t[e]=function(t,n){return n?("component"===e&&f(n)&&(n.name=n.name||t,n=this.options._base.extend(n)),"directive"===e&&"function"===typeof n&&(n={bind:n,update:n}),this.options[e+"s"][t]=n,n):this.options[e+"s"][t]}}))}function Tr(t){return t&&(t.Ctor.options.name||t.tag)}function Ir(t,e){return Array.isArray(t)?t.indexOf(e)>-1:"string"===typeof t?t.split(",").indexOf(e)>-1:!!l(t)&&t.test(e)}function Pr(t,e){var n=t.cache,r=t.keys,o=t._vnode;for(var i in n){var a=n[i];if(a){var c=a.name;c&&!e(c)&&Lr(n,i,r,o)}}}function Lr(t,e,n,r){var o=t[e];!o||r&&o.tag===r.tag||o.componentInstance.$destroy(),t[e]=null,g(n,e)}_r(Sr),gr(Sr),$n(Sr),Ln(Sr),_n(Sr);var Nr=[String,RegExp,Array],Dr={name:"keep-alive",abstract:!0,props:{include:Nr,exclude:Nr,max:[String,Number]},methods:{cacheVNode:function(){var t=this,e=t.cache,n=t.keys,r=t.vnodeToCache,o=t.keyToCache;if(r){var i=r.tag,a=r.componentInstance,c=r.componentOptions;e[o]={name:Tr(c),tag:i,componentInstance:a},n.push(o),this.max&&n.length>parseInt(this.max)&&Lr(e,n[0],n,this._vnode),this.vnodeToCache=null}}},created:function(){this.cache=Object.create(null),this.keys=[]},destroyed:function(){for(var t in this.cache)Lr(this.cache,t,this.keys)},mounted:function(){var t=this;this.cacheVNode(),this.$watch("include",(function(e){Pr(t,(function(t){return Ir(e,t)}))})),this.$watch("exclude",(function(e){Pr(t,(function(t){return!Ir(e,t)}))}))},updated:function(){this.cacheVNode()},render:function(){var t=this.$slots.default,e=Sn(t),n=e&&e.componentOptions;if(n){var r=Tr(n),o=this,i=o.include,a=o.exclude;if(i&&(!r||!Ir(i,r))||a&&r&&Ir(a,r))return e;var c=this,s=c.cache,u=c.keys,f=null==e.key?n.Ctor.cid+(n.tag?"::"+n.tag:""):e.key;s[f]?(e.componentInstance=s[f].componentInstance,g(u,f),u.push(f)):(this.vnodeToCache=e,this.keyToCache=f),e.data.keepAlive=!0}return e||t&&t[0]}},Fr={KeepAlive:Dr};function Rr(t){var e={get:function(){return B}};Object.defineProperty(t,"config",e),t.util={warn:pt,extend:T,mergeOptions:qt,defineReactive:Lt},t.set=Nt,t.delete=Dt,t.nextTick=he,t.observable=function(t){return Pt(t),t},t.options=Object.create(null),U.forEach((function(e){t.options[e+"s"]=Object.create(null)})),t.options._base=t,T(t.options.components,Fr),Ar(t),Cr(t),Er(t),$r(t)}Rr(Sr),Object.defineProperty(Sr.prototype,"$isServer",{get:st}),Object.defineProperty(Sr.prototype,"$ssrContext",{get:function(){return this.$vnode&&this.$vnode.ssrContext}}),Object.defineProperty(Sr,"FunctionalRenderContext",{value:Qe}),Sr.version="2.6.14";var Mr=y("style,class"),Ur=y("input,textarea,option,select,progress"),Vr=function(t,e,n){return"value"===n&&Ur(t)&&"button"!==e||"selected"===n&&"option"===t||"checked"===n&&"input"===t||"muted"===n&&"video"===t},Br=y("contenteditable,draggable,spellcheck"),Gr=y("events,caret,typing,plaintext-only"),Hr=function(t,e){return Xr(e)||"false"===e?"false":"contenteditable"===t&&Gr(e)?e:"true"},zr=y("allowfullscreen,async,autofocus,autoplay,checked,compact,controls,declare,default,defaultchecked,defaultmuted,defaultselected,defer,disabled,enabled,formnovalidate,hidden,indeterminate,inert,ismap,itemscope,loop,multiple,muted,nohref,noresize,noshade,novalidate,nowrap,open,pauseonexit,readonly,required,reversed,scoped,seamless,selected,sortable,truespeed,typemustmatch,visible"),Wr="http://www.w3.org/1999/xlink",Kr=function(t){return":"===t.charAt(5)&&"xlink"===t.slice(0,5)},qr=function(t){return Kr(t)?t.slice(6,t.length):""},Xr=function(t){return null==t||!1===t};function Jr(t){var e=t.data,n=t,r=t;while(o(r.componentInstance))r=r.componentInstance._vnode,r&&r.data&&(e=Yr(r.data,e));while(o(n=n.parent))n&&n.data&&(e=Yr(e,n.data));return Zr(e.staticClass,e.class)}function Yr(t,e){return{staticClass:Qr(t.staticClass,e.staticClass),class:o(t.class)?[t.class,e.class]:e.class}}function Zr(t,e){return o(t)||o(e)?Qr(t,to(e)):""}function Qr(t,e){return t?e?t+" "+e:t:e||""}function to(t){return Array.isArray(t)?eo(t):s(t)?no(t):"string"===typeof t?t:""}function eo(t){for(var e,n="",r=0,i=t.length;r<i;r++)o(e=to(t[r]))&&""!==e&&(n&&(n+=" "),n+=e);return n}function no(t){var e="";for(var n in t)t[n]&&(e&&(e+=" "),e+=n);return e}var ro={svg:"http://www.w3.org/2000/svg",math:"http://www.w3.org/1998/Math/MathML"},oo=y("html,body,base,head,link,meta,style,title,address,article,aside,footer,header,h1,h2,h3,h4,h5,h6,hgroup,nav,section,div,dd,dl,dt,figcaption,figure,picture,hr,img,li,main,ol,p,pre,ul,a,b,abbr,bdi,bdo,br,cite,code,data,dfn,em,i,kbd,mark,q,rp,rt,rtc,ruby,s,samp,small,span,strong,sub,sup,time,u,var,wbr,area,audio,map,track,video,embed,object,param,source,canvas,script,noscript,del,ins,caption,col,colgroup,table,thead,tbody,td,th,tr,button,datalist,fieldset,form,input,label,legend,meter,optgroup,option,output,progress,select,textarea,details,dialog,menu,menuitem,summary,content,element,shadow,template,blockquote,iframe,tfoot"),io=y("svg,animate,circle,clippath,cursor,defs,desc,ellipse,filter,font-face,foreignobject,g,glyph,image,line,marker,mask,missing-glyph,path,pattern,polygon,polyline,rect,switch,symbol,text,textpath,tspan,use,view",!0),ao=function(t){return oo(t)||io(t)};function co(t){return io(t)?"svg":"math"===t?"math":void 0}var so=Object.create(null);function uo(t){if(!J)return!0;if(ao(t))return!1;if(t=t.toLowerCase(),null!=so[t])return so[t];var e=document.createElement(t);return t.indexOf("-")>-1?so[t]=e.constructor===window.HTMLUnknownElement||e.constructor===window.HTMLElement:so[t]=/HTMLUnknownElement/.test(e.toString())}var fo=y("text,number,password,search,email,tel,url");function lo(t){if("string"===typeof t){var e=document.querySelector(t);return e||document.createElement("div")}return t}function po(t,e){var n=document.createElement(t);return"select"!==t||e.data&&e.data.attrs&&void 0!==e.data.attrs.multiple&&n.setAttribute("multiple","multiple"),n}function vo(t,e){return document.createElementNS(ro[t],e)}function ho(t){return document.createTextNode(t)}function yo(t){return document.createComment(t)}function mo(t,e,n){t.insertBefore(e,n)}function go(t,e){t.removeChild(e)}function bo(t,e){t.appendChild(e)}function _o(t){return t.parentNode}function xo(t){return t.nextSibling}function wo(t){return t.tagName}function Oo(t,e){t.textContent=e}function So(t,e){t.setAttribute(e,"")}var Ao=Object.freeze({createElement:po,createElementNS:vo,createTextNode:ho,createComment:yo,insertBefore:mo,removeChild:go,appendChild:bo,parentNode:_o,nextSibling:xo,tagName:wo,setTextContent:Oo,setStyleScope:So}),Co={create:function(t,e){Eo(e)},update:function(t,e){t.data.ref!==e.data.ref&&(Eo(t,!0),Eo(e))},destroy:function(t){Eo(t,!0)}};function Eo(t,e){var n=t.data.ref;if(o(n)){var r=t.context,i=t.componentInstance||t.elm,a=r.$refs;e?Array.isArray(a[n])?g(a[n],i):a[n]===i&&(a[n]=void 0):t.data.refInFor?Array.isArray(a[n])?a[n].indexOf(i)<0&&a[n].push(i):a[n]=[i]:a[n]=i}}var ko=new bt("",{},[]),jo=["create","activate","update","remove","destroy"];function $o(t,e){return t.key===e.key&&t.asyncFactory===e.asyncFactory&&(t.tag===e.tag&&t.isComment===e.isComment&&o(t.data)===o(e.data)&&To(t,e)||i(t.isAsyncPlaceholder)&&r(e.asyncFactory.error))}function To(t,e){if("input"!==t.tag)return!0;var n,r=o(n=t.data)&&o(n=n.attrs)&&n.type,i=o(n=e.data)&&o(n=n.attrs)&&n.type;return r===i||fo(r)&&fo(i)}function Io(t,e,n){var r,i,a={};for(r=e;r<=n;++r)i=t[r].key,o(i)&&(a[i]=r);return a}function Po(t){var e,n,a={},s=t.modules,u=t.nodeOps;for(e=0;e<jo.length;++e)for(a[jo[e]]=[],n=0;n<s.length;++n)o(s[n][jo[e]])&&a[jo[e]].push(s[n][jo[e]]);function f(t){return new bt(u.tagName(t).toLowerCase(),{},[],void 0,t)}function l(t,e){function n(){0===--n.listeners&&d(t)}return n.listeners=e,n}function d(t){var e=u.parentNode(t);o(e)&&u.removeChild(e,t)}function p(t,e,n,r,a,c,s){if(o(t.elm)&&o(c)&&(t=c[s]=Ot(t)),t.isRootInsert=!a,!v(t,e,n,r)){var f=t.data,l=t.children,d=t.tag;o(d)?(t.elm=t.ns?u.createElementNS(t.ns,d):u.createElement(d,t),w(t),b(t,l,e),o(f)&&x(t,e),g(n,t.elm,r)):i(t.isComment)?(t.elm=u.createComment(t.text),g(n,t.elm,r)):(t.elm=u.createTextNode(t.text),g(n,t.elm,r))}}function v(t,e,n,r){var a=t.data;if(o(a)){var c=o(t.componentInstance)&&a.keepAlive;if(o(a=a.hook)&&o(a=a.init)&&a(t,!1),o(t.componentInstance))return h(t,e),g(n,t.elm,r),i(c)&&m(t,e,n,r),!0}}function h(t,e){o(t.data.pendingInsert)&&(e.push.apply(e,t.data.pendingInsert),t.data.pendingInsert=null),t.elm=t.componentInstance.$el,_(t)?(x(t,e),w(t)):(Eo(t),e.push(t))}function m(t,e,n,r){var i,c=t;while(c.componentInstance)if(c=c.componentInstance._vnode,o(i=c.data)&&o(i=i.transition)){for(i=0;i<a.activate.length;++i)a.activate[i](ko,c);e.push(c);break}g(n,t.elm,r)}function g(t,e,n){o(t)&&(o(n)?u.parentNode(n)===t&&u.insertBefore(t,e,n):u.appendChild(t,e))}function b(t,e,n){if(Array.isArray(e)){0;for(var r=0;r<e.length;++r)p(e[r],n,t.elm,null,!0,e,r)}else c(t.text)&&u.appendChild(t.elm,u.createTextNode(String(t.text)))}function _(t){while(t.componentInstance)t=t.componentInstance._vnode;return o(t.tag)}function x(t,n){for(var r=0;r<a.create.length;++r)a.create[r](ko,t);e=t.data.hook,o(e)&&(o(e.create)&&e.create(ko,t),o(e.insert)&&n.push(t))}function w(t){var e;if(o(e=t.fnScopeId))u.setStyleScope(t.elm,e);else{var n=t;while(n)o(e=n.context)&&o(e=e.$options._scopeId)&&u.setStyleScope(t.elm,e),n=n.parent}o(e=Tn)&&e!==t.context&&e!==t.fnContext&&o(e=e.$options._scopeId)&&u.setStyleScope(t.elm,e)}function O(t,e,n,r,o,i){for(;r<=o;++r)p(n[r],i,t,e,!1,n,r)}function S(t){var e,n,r=t.data;if(o(r))for(o(e=r.hook)&&o(e=e.destroy)&&e(t),e=0;e<a.destroy.length;++e)a.destroy[e](t);if(o(e=t.children))for(n=0;n<t.children.length;++n)S(t.children[n])}function A(t,e,n){for(;e<=n;++e){var r=t[e];o(r)&&(o(r.tag)?(C(r),S(r)):d(r.elm))}}function C(t,e){if(o(e)||o(t.data)){var n,r=a.remove.length+1;for(o(e)?e.listeners+=r:e=l(t.elm,r),o(n=t.componentInstance)&&o(n=n._vnode)&&o(n.data)&&C(n,e),n=0;n<a.remove.length;++n)a.remove[n](t,e);o(n=t.data.hook)&&o(n=n.remove)?n(t,e):e()}else d(t.elm)}function E(t,e,n,i,a){var c,s,f,l,d=0,v=0,h=e.length-1,y=e[0],m=e[h],g=n.length-1,b=n[0],_=n[g],x=!a;while(d<=h&&v<=g)r(y)?y=e[++d]:r(m)?m=e[--h]:$o(y,b)?(j(y,b,i,n,v),y=e[++d],b=n[++v]):$o(m,_)?(j(m,_,i,n,g),m=e[--h],_=n[--g]):$o(y,_)?(j(y,_,i,n,g),x&&u.insertBefore(t,y.elm,u.nextSibling(m.elm)),y=e[++d],_=n[--g]):$o(m,b)?(j(m,b,i,n,v),x&&u.insertBefore(t,m.elm,y.elm),m=e[--h],b=n[++v]):(r(c)&&(c=Io(e,d,h)),s=o(b.key)?c[b.key]:k(b,e,d,h),r(s)?p(b,i,t,y.elm,!1,n,v):(f=e[s],$o(f,b)?(j(f,b,i,n,v),e[s]=void 0,x&&u.insertBefore(t,f.elm,y.elm)):p(b,i,t,y.elm,!1,n,v)),b=n[++v]);d>h?(l=r(n[g+1])?null:n[g+1].elm,O(t,l,n,v,g,i)):v>g&&A(e,d,h)}function k(t,e,n,r){for(var i=n;i<r;i++){var a=e[i];if(o(a)&&$o(t,a))return i}}function j(t,e,n,c,s,f){if(t!==e){o(e.elm)&&o(c)&&(e=c[s]=Ot(e));var l=e.elm=t.elm;if(i(t.isAsyncPlaceholder))o(e.asyncFactory.resolved)?I(t.elm,e,n):e.isAsyncPlaceholder=!0;else if(i(e.isStatic)&&i(t.isStatic)&&e.key===t.key&&(i(e.isCloned)||i(e.isOnce)))e.componentInstance=t.componentInstance;else{var d,p=e.data;o(p)&&o(d=p.hook)&&o(d=d.prepatch)&&d(t,e);var v=t.children,h=e.children;if(o(p)&&_(e)){for(d=0;d<a.update.length;++d)a.update[d](t,e);o(d=p.hook)&&o(d=d.update)&&d(t,e)}r(e.text)?o(v)&&o(h)?v!==h&&E(l,v,h,n,f):o(h)?(o(t.text)&&u.setTextContent(l,""),O(l,null,h,0,h.length-1,n)):o(v)?A(v,0,v.length-1):o(t.text)&&u.setTextContent(l,""):t.text!==e.text&&u.setTextContent(l,e.text),o(p)&&o(d=p.hook)&&o(d=d.postpatch)&&d(t,e)}}}function $(t,e,n){if(i(n)&&o(t.parent))t.parent.data.pendingInsert=e;else for(var r=0;r<e.length;++r)e[r].data.hook.insert(e[r])}var T=y("attrs,class,staticClass,staticStyle,key");function I(t,e,n,r){var a,c=e.tag,s=e.data,u=e.children;if(r=r||s&&s.pre,e.elm=t,i(e.isComment)&&o(e.asyncFactory))return e.isAsyncPlaceholder=!0,!0;if(o(s)&&(o(a=s.hook)&&o(a=a.init)&&a(e,!0),o(a=e.componentInstance)))return h(e,n),!0;if(o(c)){if(o(u))if(t.hasChildNodes())if(o(a=s)&&o(a=a.domProps)&&o(a=a.innerHTML)){if(a!==t.innerHTML)return!1}else{for(var f=!0,l=t.firstChild,d=0;d<u.length;d++){if(!l||!I(l,u[d],n,r)){f=!1;break}l=l.nextSibling}if(!f||l)return!1}else b(e,u,n);if(o(s)){var p=!1;for(var v in s)if(!T(v)){p=!0,x(e,n);break}!p&&s["class"]&&me(s["class"])}}else t.data!==e.text&&(t.data=e.text);return!0}return function(t,e,n,c){if(!r(e)){var s=!1,l=[];if(r(t))s=!0,p(e,l);else{var d=o(t.nodeType);if(!d&&$o(t,e))j(t,e,l,null,null,c);else{if(d){if(1===t.nodeType&&t.hasAttribute(M)&&(t.removeAttribute(M),n=!0),i(n)&&I(t,e,l))return $(e,l,!0),t;t=f(t)}var v=t.elm,h=u.parentNode(v);if(p(e,l,v._leaveCb?null:h,u.nextSibling(v)),o(e.parent)){var y=e.parent,m=_(e);while(y){for(var g=0;g<a.destroy.length;++g)a.destroy[g](y);if(y.elm=e.elm,m){for(var b=0;b<a.create.length;++b)a.create[b](ko,y);var x=y.data.hook.insert;if(x.merged)for(var w=1;w<x.fns.length;w++)x.fns[w]()}else Eo(y);y=y.parent}}o(h)?A([t],0,0):o(t.tag)&&S(t)}}return $(e,l,s),e.elm}o(t)&&S(t)}}var Lo={create:No,update:No,destroy:function(t){No(t,ko)}};function No(t,e){(t.data.directives||e.data.directives)&&Do(t,e)}function Do(t,e){var n,r,o,i=t===ko,a=e===ko,c=Ro(t.data.directives,t.context),s=Ro(e.data.directives,e.context),u=[],f=[];for(n in s)r=c[n],o=s[n],r?(o.oldValue=r.value,o.oldArg=r.arg,Uo(o,"update",e,t),o.def&&o.def.componentUpdated&&f.push(o)):(Uo(o,"bind",e,t),o.def&&o.def.inserted&&u.push(o));if(u.length){var l=function(){for(var n=0;n<u.length;n++)Uo(u[n],"inserted",e,t)};i?we(e,"insert",l):l()}if(f.length&&we(e,"postpatch",(function(){for(var n=0;n<f.length;n++)Uo(f[n],"componentUpdated",e,t)})),!i)for(n in c)s[n]||Uo(c[n],"unbind",t,t,a)}var Fo=Object.create(null);function Ro(t,e){var n,r,o=Object.create(null);if(!t)return o;for(n=0;n<t.length;n++)r=t[n],r.modifiers||(r.modifiers=Fo),o[Mo(r)]=r,r.def=Xt(e.$options,"directives",r.name,!0);return o}function Mo(t){return t.rawName||t.name+"."+Object.keys(t.modifiers||{}).join(".")}function Uo(t,e,n,r,o){var i=t.def&&t.def[e];if(i)try{i(n.elm,t,n,r,o)}catch(Sa){ne(Sa,n.context,"directive "+t.name+" "+e+" hook")}}var Vo=[Co,Lo];function Bo(t,e){var n=e.componentOptions;if((!o(n)||!1!==n.Ctor.options.inheritAttrs)&&(!r(t.data.attrs)||!r(e.data.attrs))){var i,a,c,s=e.elm,u=t.data.attrs||{},f=e.data.attrs||{};for(i in o(f.__ob__)&&(f=e.data.attrs=T({},f)),f)a=f[i],c=u[i],c!==a&&Go(s,i,a,e.data.pre);for(i in(tt||nt)&&f.value!==u.value&&Go(s,"value",f.value),u)r(f[i])&&(Kr(i)?s.removeAttributeNS(Wr,qr(i)):Br(i)||s.removeAttribute(i))}}function Go(t,e,n,r){r||t.tagName.indexOf("-")>-1?Ho(t,e,n):zr(e)?Xr(n)?t.removeAttribute(e):(n="allowfullscreen"===e&&"EMBED"===t.tagName?"true":e,t.setAttribute(e,n)):Br(e)?t.setAttribute(e,Hr(e,n)):Kr(e)?Xr(n)?t.removeAttributeNS(Wr,qr(e)):t.setAttributeNS(Wr,e,n):Ho(t,e,n)}function Ho(t,e,n){if(Xr(n))t.removeAttribute(e);else{if(tt&&!et&&"TEXTAREA"===t.tagName&&"placeholder"===e&&""!==n&&!t.__ieph){var r=function(e){e.stopImmediatePropagation(),t.removeEventListener("input",r)};t.addEventListener("input",r),t.__ieph=!0}t.setAttribute(e,n)}}var zo={create:Bo,update:Bo};function Wo(t,e){var n=e.elm,i=e.data,a=t.data;if(!(r(i.staticClass)&&r(i.class)&&(r(a)||r(a.staticClass)&&r(a.class)))){var c=Jr(e),s=n._transitionClasses;o(s)&&(c=Qr(c,to(s))),c!==n._prevClass&&(n.setAttribute("class",c),n._prevClass=c)}}var Ko,qo={create:Wo,update:Wo},Xo="__r",Jo="__c";function Yo(t){if(o(t[Xo])){var e=tt?"change":"input";t[e]=[].concat(t[Xo],t[e]||[]),delete t[Xo]}o(t[Jo])&&(t.change=[].concat(t[Jo],t.change||[]),delete t[Jo])}function Zo(t,e,n){var r=Ko;return function o(){var i=e.apply(null,arguments);null!==i&&ei(t,o,n,r)}}var Qo=ce&&!(ot&&Number(ot[1])<=53);function ti(t,e,n,r){if(Qo){var o=qn,i=e;e=i._wrapper=function(t){if(t.target===t.currentTarget||t.timeStamp>=o||t.timeStamp<=0||t.target.ownerDocument!==document)return i.apply(this,arguments)}}Ko.addEventListener(t,e,at?{capture:n,passive:r}:n)}function ei(t,e,n,r){(r||Ko).removeEventListener(t,e._wrapper||e,n)}function ni(t,e){if(!r(t.data.on)||!r(e.data.on)){var n=e.data.on||{},o=t.data.on||{};Ko=e.elm,Yo(n),xe(n,o,ti,ei,Zo,e.context),Ko=void 0}}var ri,oi={create:ni,update:ni};function ii(t,e){if(!r(t.data.domProps)||!r(e.data.domProps)){var n,i,a=e.elm,c=t.data.domProps||{},s=e.data.domProps||{};for(n in o(s.__ob__)&&(s=e.data.domProps=T({},s)),c)n in s||(a[n]="");for(n in s){if(i=s[n],"textContent"===n||"innerHTML"===n){if(e.children&&(e.children.length=0),i===c[n])continue;1===a.childNodes.length&&a.removeChild(a.childNodes[0])}if("value"===n&&"PROGRESS"!==a.tagName){a._value=i;var u=r(i)?"":String(i);ai(a,u)&&(a.value=u)}else if("innerHTML"===n&&io(a.tagName)&&r(a.innerHTML)){ri=ri||document.createElement("div"),ri.innerHTML="<svg>"+i+"</svg>";var f=ri.firstChild;while(a.firstChild)a.removeChild(a.firstChild);while(f.firstChild)a.appendChild(f.firstChild)}else if(i!==c[n])try{a[n]=i}catch(Sa){}}}}function ai(t,e){return!t.composing&&("OPTION"===t.tagName||ci(t,e)||si(t,e))}function ci(t,e){var n=!0;try{n=document.activeElement!==t}catch(Sa){}return n&&t.value!==e}function si(t,e){var n=t.value,r=t._vModifiers;if(o(r)){if(r.number)return h(n)!==h(e);if(r.trim)return n.trim()!==e.trim()}return n!==e}var ui={create:ii,update:ii},fi=x((function(t){var e={},n=/;(?![^(]*\))/g,r=/:(.+)/;return t.split(n).forEach((function(t){if(t){var n=t.split(r);n.length>1&&(e[n[0].trim()]=n[1].trim())}})),e}));function li(t){var e=di(t.style);return t.staticStyle?T(t.staticStyle,e):e}function di(t){return Array.isArray(t)?I(t):"string"===typeof t?fi(t):t}function pi(t,e){var n,r={};if(e){var o=t;while(o.componentInstance)o=o.componentInstance._vnode,o&&o.data&&(n=li(o.data))&&T(r,n)}(n=li(t.data))&&T(r,n);var i=t;while(i=i.parent)i.data&&(n=li(i.data))&&T(r,n);return r}var vi,hi=/^--/,yi=/\s*!important$/,mi=function(t,e,n){if(hi.test(e))t.style.setProperty(e,n);else if(yi.test(n))t.style.setProperty(C(e),n.replace(yi,""),"important");else{var r=bi(e);if(Array.isArray(n))for(var o=0,i=n.length;o<i;o++)t.style[r]=n[o];else t.style[r]=n}},gi=["Webkit","Moz","ms"],bi=x((function(t){if(vi=vi||document.createElement("div").style,t=O(t),"filter"!==t&&t in vi)return t;for(var e=t.charAt(0).toUpperCase()+t.slice(1),n=0;n<gi.length;n++){var r=gi[n]+e;if(r in vi)return r}}));function _i(t,e){var n=e.data,i=t.data;if(!(r(n.staticStyle)&&r(n.style)&&r(i.staticStyle)&&r(i.style))){var a,c,s=e.elm,u=i.staticStyle,f=i.normalizedStyle||i.style||{},l=u||f,d=di(e.data.style)||{};e.data.normalizedStyle=o(d.__ob__)?T({},d):d;var p=pi(e,!0);for(c in l)r(p[c])&&mi(s,c,"");for(c in p)a=p[c],a!==l[c]&&mi(s,c,null==a?"":a)}}var xi={create:_i,update:_i},wi=/\s+/;function Oi(t,e){if(e&&(e=e.trim()))if(t.classList)e.indexOf(" ")>-1?e.split(wi).forEach((function(e){return t.classList.add(e)})):t.classList.add(e);else{var n=" "+(t.getAttribute("class")||"")+" ";n.indexOf(" "+e+" ")<0&&t.setAttribute("class",(n+e).trim())}}function Si(t,e){if(e&&(e=e.trim()))if(t.classList)e.indexOf(" ")>-1?e.split(wi).forEach((function(e){return t.classList.remove(e)})):t.classList.remove(e),t.classList.length||t.removeAttribute("class");else{var n=" "+(t.getAttribute("class")||"")+" ",r=" "+e+" ";while(n.indexOf(r)>=0)n=n.replace(r," ");n=n.trim(),n?t.setAttribute("class",n):t.removeAttribute("class")}}function Ai(t){if(t){if("object"===typeof t){var e={};return!1!==t.css&&T(e,Ci(t.name||"v")),T(e,t),e}return"string"===typeof t?Ci(t):void 0}}var Ci=x((function(t){return{enterClass:t+"-enter",enterToClass:t+"-enter-to",enterActiveClass:t+"-enter-active",leaveClass:t+"-leave",leaveToClass:t+"-leave-to",leaveActiveClass:t+"-leave-active"}})),Ei=J&&!et,ki="transition",ji="animation",$i="transition",Ti="transitionend",Ii="animation",Pi="animationend";Ei&&(void 0===window.ontransitionend&&void 0!==window.onwebkittransitionend&&($i="WebkitTransition",Ti="webkitTransitionEnd"),void 0===window.onanimationend&&void 0!==window.onwebkitanimationend&&(Ii="WebkitAnimation",Pi="webkitAnimationEnd"));var Li=J?window.requestAnimationFrame?window.requestAnimationFrame.bind(window):setTimeout:function(t){return t()};function Ni(t){Li((function(){Li(t)}))}function Di(t,e){var n=t._transitionClasses||(t._transitionClasses=[]);n.indexOf(e)<0&&(n.push(e),Oi(t,e))}function Fi(t,e){t._transitionClasses&&g(t._transitionClasses,e),Si(t,e)}function Ri(t,e,n){var r=Ui(t,e),o=r.type,i=r.timeout,a=r.propCount;if(!o)return n();var c=o===ki?Ti:Pi,s=0,u=function(){t.removeEventListener(c,f),n()},f=function(e){e.target===t&&++s>=a&&u()};setTimeout((function(){s<a&&u()}),i+1),t.addEventListener(c,f)}var Mi=/\b(transform|all)(,|$)/;function Ui(t,e){var n,r=window.getComputedStyle(t),o=(r[$i+"Delay"]||"").split(", "),i=(r[$i+"Duration"]||"").split(", "),a=Vi(o,i),c=(r[Ii+"Delay"]||"").split(", "),s=(r[Ii+"Duration"]||"").split(", "),u=Vi(c,s),f=0,l=0;e===ki?a>0&&(n=ki,f=a,l=i.length):e===ji?u>0&&(n=ji,f=u,l=s.length):(f=Math.max(a,u),n=f>0?a>u?ki:ji:null,l=n?n===ki?i.length:s.length:0);var d=n===ki&&Mi.test(r[$i+"Property"]);return{type:n,timeout:f,propCount:l,hasTransform:d}}function Vi(t,e){while(t.length<e.length)t=t.concat(t);return Math.max.apply(null,e.map((function(e,n){return Bi(e)+Bi(t[n])})))}function Bi(t){return 1e3*Number(t.slice(0,-1).replace(",","."))}function Gi(t,e){var n=t.elm;o(n._leaveCb)&&(n._leaveCb.cancelled=!0,n._leaveCb());var i=Ai(t.data.transition);if(!r(i)&&!o(n._enterCb)&&1===n.nodeType){var a=i.css,c=i.type,u=i.enterClass,f=i.enterToClass,l=i.enterActiveClass,d=i.appearClass,p=i.appearToClass,v=i.appearActiveClass,y=i.beforeEnter,m=i.enter,g=i.afterEnter,b=i.enterCancelled,_=i.beforeAppear,x=i.appear,w=i.afterAppear,O=i.appearCancelled,S=i.duration,A=Tn,C=Tn.$vnode;while(C&&C.parent)A=C.context,C=C.parent;var E=!A._isMounted||!t.isRootInsert;if(!E||x||""===x){var k=E&&d?d:u,j=E&&v?v:l,$=E&&p?p:f,T=E&&_||y,I=E&&"function"===typeof x?x:m,P=E&&w||g,L=E&&O||b,N=h(s(S)?S.enter:S);0;var D=!1!==a&&!et,F=Wi(I),M=n._enterCb=R((function(){D&&(Fi(n,$),Fi(n,j)),M.cancelled?(D&&Fi(n,k),L&&L(n)):P&&P(n),n._enterCb=null}));t.data.show||we(t,"insert",(function(){var e=n.parentNode,r=e&&e._pending&&e._pending[t.key];r&&r.tag===t.tag&&r.elm._leaveCb&&r.elm._leaveCb(),I&&I(n,M)})),T&&T(n),D&&(Di(n,k),Di(n,j),Ni((function(){Fi(n,k),M.cancelled||(Di(n,$),F||(zi(N)?setTimeout(M,N):Ri(n,c,M)))}))),t.data.show&&(e&&e(),I&&I(n,M)),D||F||M()}}}function Hi(t,e){var n=t.elm;o(n._enterCb)&&(n._enterCb.cancelled=!0,n._enterCb());var i=Ai(t.data.transition);if(r(i)||1!==n.nodeType)return e();if(!o(n._leaveCb)){var a=i.css,c=i.type,u=i.leaveClass,f=i.leaveToClass,l=i.leaveActiveClass,d=i.beforeLeave,p=i.leave,v=i.afterLeave,y=i.leaveCancelled,m=i.delayLeave,g=i.duration,b=!1!==a&&!et,_=Wi(p),x=h(s(g)?g.leave:g);0;var w=n._leaveCb=R((function(){n.parentNode&&n.parentNode._pending&&(n.parentNode._pending[t.key]=null),b&&(Fi(n,f),Fi(n,l)),w.cancelled?(b&&Fi(n,u),y&&y(n)):(e(),v&&v(n)),n._leaveCb=null}));m?m(O):O()}function O(){w.cancelled||(!t.data.show&&n.parentNode&&((n.parentNode._pending||(n.parentNode._pending={}))[t.key]=t),d&&d(n),b&&(Di(n,u),Di(n,l),Ni((function(){Fi(n,u),w.cancelled||(Di(n,f),_||(zi(x)?setTimeout(w,x):Ri(n,c,w)))}))),p&&p(n,w),b||_||w())}}function zi(t){return"number"===typeof t&&!isNaN(t)}function Wi(t){if(r(t))return!1;var e=t.fns;return o(e)?Wi(Array.isArray(e)?e[0]:e):(t._length||t.length)>1}function Ki(t,e){!0!==e.data.show&&Gi(e)}var qi=J?{create:Ki,activate:Ki,remove:function(t,e){!0!==t.data.show?Hi(t,e):e()}}:{},Xi=[zo,qo,oi,ui,xi,qi],Ji=Xi.concat(Vo),Yi=Po({nodeOps:Ao,modules:Ji});et&&document.addEventListener("selectionchange",(function(){var t=document.activeElement;t&&t.vmodel&&ia(t,"input")}));var Zi={inserted:function(t,e,n,r){"select"===n.tag?(r.elm&&!r.elm._vOptions?we(n,"postpatch",(function(){Zi.componentUpdated(t,e,n)})):Qi(t,e,n.context),t._vOptions=[].map.call(t.options,na)):("textarea"===n.tag||fo(t.type))&&(t._vModifiers=e.modifiers,e.modifiers.lazy||(t.addEventListener("compositionstart",ra),t.addEventListener("compositionend",oa),t.addEventListener("change",oa),et&&(t.vmodel=!0)))},componentUpdated:function(t,e,n){if("select"===n.tag){Qi(t,e,n.context);var r=t._vOptions,o=t._vOptions=[].map.call(t.options,na);if(o.some((function(t,e){return!D(t,r[e])}))){var i=t.multiple?e.value.some((function(t){return ea(t,o)})):e.value!==e.oldValue&&ea(e.value,o);i&&ia(t,"change")}}}};function Qi(t,e,n){ta(t,e,n),(tt||nt)&&setTimeout((function(){ta(t,e,n)}),0)}function ta(t,e,n){var r=e.value,o=t.multiple;if(!o||Array.isArray(r)){for(var i,a,c=0,s=t.options.length;c<s;c++)if(a=t.options[c],o)i=F(r,na(a))>-1,a.selected!==i&&(a.selected=i);else if(D(na(a),r))return void(t.selectedIndex!==c&&(t.selectedIndex=c));o||(t.selectedIndex=-1)}}function ea(t,e){return e.every((function(e){return!D(e,t)}))}function na(t){return"_value"in t?t._value:t.value}function ra(t){t.target.composing=!0}function oa(t){t.target.composing&&(t.target.composing=!1,ia(t.target,"input"))}function ia(t,e){var n=document.createEvent("HTMLEvents");n.initEvent(e,!0,!0),t.dispatchEvent(n)}function aa(t){return!t.componentInstance||t.data&&t.data.transition?t:aa(t.componentInstance._vnode)}var ca={bind:function(t,e,n){var r=e.value;n=aa(n);var o=n.data&&n.data.transition,i=t.__vOriginalDisplay="none"===t.style.display?"":t.style.display;r&&o?(n.data.show=!0,Gi(n,(function(){t.style.display=i}))):t.style.display=r?i:"none"},update:function(t,e,n){var r=e.value,o=e.oldValue;if(!r!==!o){n=aa(n);var i=n.data&&n.data.transition;i?(n.data.show=!0,r?Gi(n,(function(){t.style.display=t.__vOriginalDisplay})):Hi(n,(function(){t.style.display="none"}))):t.style.display=r?t.__vOriginalDisplay:"none"}},unbind:function(t,e,n,r,o){o||(t.style.display=t.__vOriginalDisplay)}},sa={model:Zi,show:ca},ua={name:String,appear:Boolean,css:Boolean,mode:String,type:String,enterClass:String,leaveClass:String,enterToClass:String,leaveToClass:String,enterActiveClass:String,leaveActiveClass:String,appearClass:String,appearActiveClass:String,appearToClass:String,duration:[Number,String,Object]};function fa(t){var e=t&&t.componentOptions;return e&&e.Ctor.options.abstract?fa(Sn(e.children)):t}function la(t){var e={},n=t.$options;for(var r in n.propsData)e[r]=t[r];var o=n._parentListeners;for(var i in o)e[O(i)]=o[i];return e}function da(t,e){if(/\d-keep-alive$/.test(e.tag))return t("keep-alive",{props:e.componentOptions.propsData})}function pa(t){while(t=t.parent)if(t.data.transition)return!0}function va(t,e){return e.key===t.key&&e.tag===t.tag}var ha=function(t){return t.tag||Le(t)},ya=function(t){return"show"===t.name},ma={name:"transition",props:ua,abstract:!0,render:function(t){var e=this,n=this.$slots.default;if(n&&(n=n.filter(ha),n.length)){0;var r=this.mode;0;var o=n[0];if(pa(this.$vnode))return o;var i=fa(o);if(!i)return o;if(this._leaving)return da(t,o);var a="__transition-"+this._uid+"-";i.key=null==i.key?i.isComment?a+"comment":a+i.tag:c(i.key)?0===String(i.key).indexOf(a)?i.key:a+i.key:i.key;var s=(i.data||(i.data={})).transition=la(this),u=this._vnode,f=fa(u);if(i.data.directives&&i.data.directives.some(ya)&&(i.data.show=!0),f&&f.data&&!va(i,f)&&!Le(f)&&(!f.componentInstance||!f.componentInstance._vnode.isComment)){var l=f.data.transition=T({},s);if("out-in"===r)return this._leaving=!0,we(l,"afterLeave",(function(){e._leaving=!1,e.$forceUpdate()})),da(t,o);if("in-out"===r){if(Le(i))return u;var d,p=function(){d()};we(s,"afterEnter",p),we(s,"enterCancelled",p),we(l,"delayLeave",(function(t){d=t}))}}return o}}},ga=T({tag:String,moveClass:String},ua);delete ga.mode;var ba={props:ga,beforeMount:function(){var t=this,e=this._update;this._update=function(n,r){var o=In(t);t.__patch__(t._vnode,t.kept,!1,!0),t._vnode=t.kept,o(),e.call(t,n,r)}},render:function(t){for(var e=this.tag||this.$vnode.data.tag||"span",n=Object.create(null),r=this.prevChildren=this.children,o=this.$slots.default||[],i=this.children=[],a=la(this),c=0;c<o.length;c++){var s=o[c];if(s.tag)if(null!=s.key&&0!==String(s.key).indexOf("__vlist"))i.push(s),n[s.key]=s,(s.data||(s.data={})).transition=a;else;}if(r){for(var u=[],f=[],l=0;l<r.length;l++){var d=r[l];d.data.transition=a,d.data.pos=d.elm.getBoundingClientRect(),n[d.key]?u.push(d):f.push(d)}this.kept=t(e,null,u),this.removed=f}return t(e,null,i)},updated:function(){var t=this.prevChildren,e=this.moveClass||(this.name||"v")+"-move";t.length&&this.hasMove(t[0].elm,e)&&(t.forEach(_a),t.forEach(xa),t.forEach(wa),this._reflow=document.body.offsetHeight,t.forEach((function(t){if(t.data.moved){var n=t.elm,r=n.style;Di(n,e),r.transform=r.WebkitTransform=r.transitionDuration="",n.addEventListener(Ti,n._moveCb=function t(r){r&&r.target!==n||r&&!/transform$/.test(r.propertyName)||(n.removeEventListener(Ti,t),n._moveCb=null,Fi(n,e))})}})))},methods:{hasMove:function(t,e){if(!Ei)return!1;if(this._hasMove)return this._hasMove;var n=t.cloneNode();t._transitionClasses&&t._transitionClasses.forEach((function(t){Si(n,t)})),Oi(n,e),n.style.display="none",this.$el.appendChild(n);var r=Ui(n);return this.$el.removeChild(n),this._hasMove=r.hasTransform}}};function _a(t){t.elm._moveCb&&t.elm._moveCb(),t.elm._enterCb&&t.elm._enterCb()}function xa(t){t.data.newPos=t.elm.getBoundingClientRect()}function wa(t){var e=t.data.pos,n=t.data.newPos,r=e.left-n.left,o=e.top-n.top;if(r||o){t.data.moved=!0;var i=t.elm.style;i.transform=i.WebkitTransform="translate("+r+"px,"+o+"px)",i.transitionDuration="0s"}}var Oa={Transition:ma,TransitionGroup:ba};Sr.config.mustUseProp=Vr,Sr.config.isReservedTag=ao,Sr.config.isReservedAttr=Mr,Sr.config.getTagNamespace=co,Sr.config.isUnknownElement=uo,T(Sr.options.directives,sa),T(Sr.options.components,Oa),Sr.prototype.__patch__=J?Yi:P,Sr.prototype.$mount=function(t,e){return t=t&&J?lo(t):void 0,Nn(this,t,e)},J&&setTimeout((function(){B.devtools&&ut&&ut.emit("init",Sr)}),0),e["a"]=Sr}).call(this,n("c8ba"))},"2ba4":function(t,e){var n=Function.prototype,r=n.apply,o=n.bind,i=n.call;t.exports="object"==typeof Reflect&&Reflect.apply||(o?i.bind(r):function(){return i.apply(r,arguments)})},"2cf4":function(t,e,n){var r,o,i,a,c=n("da84"),s=n("2ba4"),u=n("0366"),f=n("1626"),l=n("1a2d"),d=n("d039"),p=n("1be4"),v=n("f36a"),h=n("cc12"),y=n("1cdc"),m=n("605d"),g=c.setImmediate,b=c.clearImmediate,_=c.process,x=c.Dispatch,w=c.Function,O=c.MessageChannel,S=c.String,A=0,C={},E="onreadystatechange";try{r=c.location}catch(I){}var k=function(t){if(l(C,t)){var e=C[t];delete C[t],e()}},j=function(t){return function(){k(t)}},$=function(t){k(t.data)},T=function(t){c.postMessage(S(t),r.protocol+"//"+r.host)};g&&b||(g=function(t){var e=v(arguments,1);return C[++A]=function(){s(f(t)?t:w(t),void 0,e)},o(A),A},b=function(t){delete C[t]},m?o=function(t){_.nextTick(j(t))}:x&&x.now?o=function(t){x.now(j(t))}:O&&!y?(i=new O,a=i.port2,i.port1.onmessage=$,o=u(a.postMessage,a)):c.addEventListener&&f(c.postMessage)&&!c.importScripts&&r&&"file:"!==r.protocol&&!d(T)?(o=T,c.addEventListener("message",$,!1)):o=E in h("script")?function(t){p.appendChild(h("script"))[E]=function(){p.removeChild(this),k(t)}}:function(t){setTimeout(j(t),0)}),t.exports={set:g,clear:b}},"2d00":function(t,e,n){var r,o,i=n("da84"),a=n("342f"),c=i.process,s=i.Deno,u=c&&c.versions||s&&s.version,f=u&&u.v8;f&&(r=f.split("."),o=r[0]>0&&r[0]<4?1:+(r[0]+r[1])),!o&&a&&(r=a.match(/Edge\/(\d+)/),(!r||r[1]>=74)&&(r=a.match(/Chrome\/(\d+)/),r&&(o=+r[1]))),t.exports=o},"342f":function(t,e,n){var r=n("d066");t.exports=r("navigator","userAgent")||""},"35a1":function(t,e,n){var r=n("f5df"),o=n("dc4a"),i=n("3f8c"),a=n("b622"),c=a("iterator");t.exports=function(t){if(void 0!=t)return o(t,c)||o(t,"@@iterator")||i[r(t)]}},"37e8":function(t,e,n){var r=n("83ab"),o=n("aed9"),i=n("9bf2"),a=n("825a"),c=n("fc6a"),s=n("df75");e.f=r&&!o?Object.defineProperties:function(t,e){a(t);var n,r=c(e),o=s(e),u=o.length,f=0;while(u>f)i.f(t,n=o[f++],r[n]);return t}},3835:function(t,e,n){"use strict";function r(t){if(Array.isArray(t))return t}n.d(e,"a",(function(){return c}));n("a4d3"),n("e01a"),n("d3b7"),n("d28b"),n("3ca3"),n("ddb0");function o(t,e){var n=null==t?null:"undefined"!==typeof Symbol&&t[Symbol.iterator]||t["@@iterator"];if(null!=n){var r,o,i=[],a=!0,c=!1;try{for(n=n.call(t);!(a=(r=n.next()).done);a=!0)if(i.push(r.value),e&&i.length===e)break}catch(s){c=!0,o=s}finally{try{a||null==n["return"]||n["return"]()}finally{if(c)throw o}}return i}}var i=n("06c5");n("d9e2");function a(){throw new TypeError("Invalid attempt to destructure non-iterable instance.\nIn order to be iterable, non-array objects must have a [Symbol.iterator]() method.")}function c(t,e){return r(t)||o(t,e)||Object(i["a"])(t,e)||a()}},"3a9b":function(t,e,n){var r=n("e330");t.exports=r({}.isPrototypeOf)},"3bbe":function(t,e,n){var r=n("da84"),o=n("1626"),i=r.String,a=r.TypeError;t.exports=function(t){if("object"==typeof t||o(t))return t;throw a("Can't set "+i(t)+" as a prototype")}},"3ca3":function(t,e,n){"use strict";var r=n("6547").charAt,o=n("577e"),i=n("69f3"),a=n("7dd0"),c="String Iterator",s=i.set,u=i.getterFor(c);a(String,"String",(function(t){s(this,{type:c,string:o(t),index:0})}),(function(){var t,e=u(this),n=e.string,o=e.index;return o>=n.length?{value:void 0,done:!0}:(t=r(n,o),e.index+=t.length,{value:t,done:!1})}))},"3f8c":function(t,e){t.exports={}},"428f":function(t,e,n){var r=n("da84");t.exports=r},"44ad":function(t,e,n){var r=n("da84"),o=n("e330"),i=n("d039"),a=n("c6b6"),c=r.Object,s=o("".split);t.exports=i((function(){return!c("z").propertyIsEnumerable(0)}))?function(t){return"String"==a(t)?s(t,""):c(t)}:c},"44d2":function(t,e,n){var r=n("b622"),o=n("7c73"),i=n("9bf2"),a=r("unscopables"),c=Array.prototype;void 0==c[a]&&i.f(c,a,{configurable:!0,value:o(null)}),t.exports=function(t){c[a][t]=!0}},"44de":function(t,e,n){var r=n("da84");t.exports=function(t,e){var n=r.console;n&&n.error&&(1==arguments.length?n.error(t):n.error(t,e))}},"44e7":function(t,e,n){var r=n("861d"),o=n("c6b6"),i=n("b622"),a=i("match");t.exports=function(t){var e;return r(t)&&(void 0!==(e=t[a])?!!e:"RegExp"==o(t))}},4840:function(t,e,n){var r=n("825a"),o=n("5087"),i=n("b622"),a=i("species");t.exports=function(t,e){var n,i=r(t).constructor;return void 0===i||void 0==(n=r(i)[a])?e:o(n)}},"485a":function(t,e,n){var r=n("da84"),o=n("c65b"),i=n("1626"),a=n("861d"),c=r.TypeError;t.exports=function(t,e){var n,r;if("string"===e&&i(n=t.toString)&&!a(r=o(n,t)))return r;if(i(n=t.valueOf)&&!a(r=o(n,t)))return r;if("string"!==e&&i(n=t.toString)&&!a(r=o(n,t)))return r;throw c("Can't convert object to primitive value")}},4930:function(t,e,n){var r=n("2d00"),o=n("d039");t.exports=!!Object.getOwnPropertySymbols&&!o((function(){var t=Symbol();return!String(t)||!(Object(t)instanceof Symbol)||!Symbol.sham&&r&&r<41}))},"4d64":function(t,e,n){var r=n("fc6a"),o=n("23cb"),i=n("07fa"),a=function(t){return function(e,n,a){var c,s=r(e),u=i(s),f=o(a,u);if(t&&n!=n){while(u>f)if(c=s[f++],c!=c)return!0}else for(;u>f;f++)if((t||f in s)&&s[f]===n)return t||f||0;return!t&&-1}};t.exports={includes:a(!0),indexOf:a(!1)}},"4dae":function(t,e,n){var r=n("da84"),o=n("23cb"),i=n("07fa"),a=n("8418"),c=r.Array,s=Math.max;t.exports=function(t,e,n){for(var r=i(t),u=o(e,r),f=o(void 0===n?r:n,r),l=c(s(f-u,0)),d=0;u<f;u++,d++)a(l,d,t[u]);return l.length=d,l}},"4de4":function(t,e,n){"use strict";var r=n("23e7"),o=n("b727").filter,i=n("1dde"),a=i("filter");r({target:"Array",proto:!0,forced:!a},{filter:function(t){return o(this,t,arguments.length>1?arguments[1]:void 0)}})},"4df4":function(t,e,n){"use strict";var r=n("da84"),o=n("0366"),i=n("c65b"),a=n("7b0b"),c=n("9bdd"),s=n("e95a"),u=n("68ee"),f=n("07fa"),l=n("8418"),d=n("9a1f"),p=n("35a1"),v=r.Array;t.exports=function(t){var e=a(t),n=u(this),r=arguments.length,h=r>1?arguments[1]:void 0,y=void 0!==h;y&&(h=o(h,r>2?arguments[2]:void 0));var m,g,b,_,x,w,O=p(e),S=0;if(!O||this==v&&s(O))for(m=f(e),g=n?new this(m):v(m);m>S;S++)w=y?h(e[S],S):e[S],l(g,S,w);else for(_=d(e,O),x=_.next,g=n?new this:[];!(b=i(x,_)).done;S++)w=y?c(_,h,[b.value,S],!0):b.value,l(g,S,w);return g.length=S,g}},"4e82":function(t,e,n){"use strict";var r=n("23e7"),o=n("e330"),i=n("59ed"),a=n("7b0b"),c=n("07fa"),s=n("577e"),u=n("d039"),f=n("addb"),l=n("a640"),d=n("04d1"),p=n("d998"),v=n("2d00"),h=n("512c"),y=[],m=o(y.sort),g=o(y.push),b=u((function(){y.sort(void 0)})),_=u((function(){y.sort(null)})),x=l("sort"),w=!u((function(){if(v)return v<70;if(!(d&&d>3)){if(p)return!0;if(h)return h<603;var t,e,n,r,o="";for(t=65;t<76;t++){switch(e=String.fromCharCode(t),t){case 66:case 69:case 70:case 72:n=3;break;case 68:case 71:n=4;break;default:n=2}for(r=0;r<47;r++)y.push({k:e+r,v:n})}for(y.sort((function(t,e){return e.v-t.v})),r=0;r<y.length;r++)e=y[r].k.charAt(0),o.charAt(o.length-1)!==e&&(o+=e);return"DGBEFHACIJK"!==o}})),O=b||!_||!x||!w,S=function(t){return function(e,n){return void 0===n?-1:void 0===e?1:void 0!==t?+t(e,n)||0:s(e)>s(n)?1:-1}};r({target:"Array",proto:!0,forced:O},{sort:function(t){void 0!==t&&i(t);var e=a(this);if(w)return void 0===t?m(e):m(e,t);var n,r,o=[],s=c(e);for(r=0;r<s;r++)r in e&&g(o,e[r]);f(o,S(t)),n=o.length,r=0;while(r<n)e[r]=o[r++];while(r<s)delete e[r++];return e}})},5087:function(t,e,n){var r=n("da84"),o=n("68ee"),i=n("0d51"),a=r.TypeError;t.exports=function(t){if(o(t))return t;throw a(i(t)+" is not a constructor")}},"50c4":function(t,e,n){var r=n("5926"),o=Math.min;t.exports=function(t){return t>0?o(r(t),9007199254740991):0}},"512c":function(t,e,n){var r=n("342f"),o=r.match(/AppleWebKit\/(\d+)\./);t.exports=!!o&&+o[1]},5692:function(t,e,n){var r=n("c430"),o=n("c6cd");(t.exports=function(t,e){return o[t]||(o[t]=void 0!==e?e:{})})("versions",[]).push({version:"3.20.2",mode:r?"pure":"global",copyright:"© 2022 Denis Pushkarev (zloirock.ru)"})},"56ef":function(t,e,n){var r=n("d066"),o=n("e330"),i=n("241c"),a=n("7418"),c=n("825a"),s=o([].concat);t.exports=r("Reflect","ownKeys")||function(t){var e=i.f(c(t)),n=a.f;return n?s(e,n(t)):e}},"577e":function(t,e,n){var r=n("da84"),o=n("f5df"),i=r.String;t.exports=function(t){if("Symbol"===o(t))throw TypeError("Cannot convert a Symbol value to a string");return i(t)}},5926:function(t,e){var n=Math.ceil,r=Math.floor;t.exports=function(t){var e=+t;return e!==e||0===e?0:(e>0?r:n)(e)}},"59ed":function(t,e,n){var r=n("da84"),o=n("1626"),i=n("0d51"),a=r.TypeError;t.exports=function(t){if(o(t))return t;throw a(i(t)+" is not a function")}},"5c6c":function(t,e){t.exports=function(t,e){return{enumerable:!(1&t),configurable:!(2&t),writable:!(4&t),value:e}}},"5e77":function(t,e,n){var r=n("83ab"),o=n("1a2d"),i=Function.prototype,a=r&&Object.getOwnPropertyDescriptor,c=o(i,"name"),s=c&&"something"===function(){}.name,u=c&&(!r||r&&a(i,"name").configurable);t.exports={EXISTS:c,PROPER:s,CONFIGURABLE:u}},"605d":function(t,e,n){var r=n("c6b6"),o=n("da84");t.exports="process"==r(o.process)},6069:function(t,e){t.exports="object"==typeof window},"60da":function(t,e,n){"use strict";var r=n("83ab"),o=n("e330"),i=n("c65b"),a=n("d039"),c=n("df75"),s=n("7418"),u=n("d1e7"),f=n("7b0b"),l=n("44ad"),d=Object.assign,p=Object.defineProperty,v=o([].concat);t.exports=!d||a((function(){if(r&&1!==d({b:1},d(p({},"a",{enumerable:!0,get:function(){p(this,"b",{value:3,enumerable:!1})}}),{b:2})).b)return!0;var t={},e={},n=Symbol(),o="abcdefghijklmnopqrst";return t[n]=7,o.split("").forEach((function(t){e[t]=t})),7!=d({},t)[n]||c(d({},e)).join("")!=o}))?function(t,e){var n=f(t),o=arguments.length,a=1,d=s.f,p=u.f;while(o>a){var h,y=l(arguments[a++]),m=d?v(c(y),d(y)):c(y),g=m.length,b=0;while(g>b)h=m[b++],r&&!i(p,y,h)||(n[h]=y[h])}return n}:d},6547:function(t,e,n){var r=n("e330"),o=n("5926"),i=n("577e"),a=n("1d80"),c=r("".charAt),s=r("".charCodeAt),u=r("".slice),f=function(t){return function(e,n){var r,f,l=i(a(e)),d=o(n),p=l.length;return d<0||d>=p?t?"":void 0:(r=s(l,d),r<55296||r>56319||d+1===p||(f=s(l,d+1))<56320||f>57343?t?c(l,d):r:t?u(l,d,d+2):f-56320+(r-55296<<10)+65536)}};t.exports={codeAt:f(!1),charAt:f(!0)}},"65f0":function(t,e,n){var r=n("0b42");t.exports=function(t,e){return new(r(t))(0===e?0:e)}},"68ee":function(t,e,n){var r=n("e330"),o=n("d039"),i=n("1626"),a=n("f5df"),c=n("d066"),s=n("8925"),u=function(){},f=[],l=c("Reflect","construct"),d=/^\s*(?:class|function)\b/,p=r(d.exec),v=!d.exec(u),h=function(t){if(!i(t))return!1;try{return l(u,f,t),!0}catch(e){return!1}},y=function(t){if(!i(t))return!1;switch(a(t)){case"AsyncFunction":case"GeneratorFunction":case"AsyncGeneratorFunction":return!1}try{return v||!!p(d,s(t))}catch(e){return!0}};y.sham=!0,t.exports=!l||o((function(){var t;return h(h.call)||!h(Object)||!h((function(){t=!0}))||t}))?y:h},"69f3":function(t,e,n){var r,o,i,a=n("7f9a"),c=n("da84"),s=n("e330"),u=n("861d"),f=n("9112"),l=n("1a2d"),d=n("c6cd"),p=n("f772"),v=n("d012"),h="Object already initialized",y=c.TypeError,m=c.WeakMap,g=function(t){return i(t)?o(t):r(t,{})},b=function(t){return function(e){var n;if(!u(e)||(n=o(e)).type!==t)throw y("Incompatible receiver, "+t+" required");return n}};if(a||d.state){var _=d.state||(d.state=new m),x=s(_.get),w=s(_.has),O=s(_.set);r=function(t,e){if(w(_,t))throw new y(h);return e.facade=t,O(_,t,e),e},o=function(t){return x(_,t)||{}},i=function(t){return w(_,t)}}else{var S=p("state");v[S]=!0,r=function(t,e){if(l(t,S))throw new y(h);return e.facade=t,f(t,S,e),e},o=function(t){return l(t,S)?t[S]:{}},i=function(t){return l(t,S)}}t.exports={set:r,get:o,has:i,enforce:g,getterFor:b}},"6eeb":function(t,e,n){var r=n("da84"),o=n("1626"),i=n("1a2d"),a=n("9112"),c=n("ce4e"),s=n("8925"),u=n("69f3"),f=n("5e77").CONFIGURABLE,l=u.get,d=u.enforce,p=String(String).split("String");(t.exports=function(t,e,n,s){var u,l=!!s&&!!s.unsafe,v=!!s&&!!s.enumerable,h=!!s&&!!s.noTargetGet,y=s&&void 0!==s.name?s.name:e;o(n)&&("Symbol("===String(y).slice(0,7)&&(y="["+String(y).replace(/^Symbol\(([^)]*)\)/,"$1")+"]"),(!i(n,"name")||f&&n.name!==y)&&a(n,"name",y),u=d(n),u.source||(u.source=p.join("string"==typeof y?y:""))),t!==r?(l?!h&&t[e]&&(v=!0):delete t[e],v?t[e]=n:a(t,e,n)):v?t[e]=n:c(e,n)})(Function.prototype,"toString",(function(){return o(this)&&l(this).source||s(this)}))},7156:function(t,e,n){var r=n("1626"),o=n("861d"),i=n("d2bb");t.exports=function(t,e,n){var a,c;return i&&r(a=e.constructor)&&a!==n&&o(c=a.prototype)&&c!==n.prototype&&i(t,c),t}},7418:function(t,e){e.f=Object.getOwnPropertySymbols},"746f":function(t,e,n){var r=n("428f"),o=n("1a2d"),i=n("e538"),a=n("9bf2").f;t.exports=function(t){var e=r.Symbol||(r.Symbol={});o(e,t)||a(e,t,{value:i.f(t)})}},7839:function(t,e){t.exports=["constructor","hasOwnProperty","isPrototypeOf","propertyIsEnumerable","toLocaleString","toString","valueOf"]},"785a":function(t,e,n){var r=n("cc12"),o=r("span").classList,i=o&&o.constructor&&o.constructor.prototype;t.exports=i===Object.prototype?void 0:i},"7b0b":function(t,e,n){var r=n("da84"),o=n("1d80"),i=r.Object;t.exports=function(t){return i(o(t))}},"7c73":function(t,e,n){var r,o=n("825a"),i=n("37e8"),a=n("7839"),c=n("d012"),s=n("1be4"),u=n("cc12"),f=n("f772"),l=">",d="<",p="prototype",v="script",h=f("IE_PROTO"),y=function(){},m=function(t){return d+v+l+t+d+"/"+v+l},g=function(t){t.write(m("")),t.close();var e=t.parentWindow.Object;return t=null,e},b=function(){var t,e=u("iframe"),n="java"+v+":";return e.style.display="none",s.appendChild(e),e.src=String(n),t=e.contentWindow.document,t.open(),t.write(m("document.F=Object")),t.close(),t.F},_=function(){try{r=new ActiveXObject("htmlfile")}catch(e){}_="undefined"!=typeof document?document.domain&&r?g(r):b():g(r);var t=a.length;while(t--)delete _[p][a[t]];return _()};c[h]=!0,t.exports=Object.create||function(t,e){var n;return null!==t?(y[p]=o(t),n=new y,y[p]=null,n[h]=t):n=_(),void 0===e?n:i.f(n,e)}},"7dd0":function(t,e,n){"use strict";var r=n("23e7"),o=n("c65b"),i=n("c430"),a=n("5e77"),c=n("1626"),s=n("9ed3"),u=n("e163"),f=n("d2bb"),l=n("d44e"),d=n("9112"),p=n("6eeb"),v=n("b622"),h=n("3f8c"),y=n("ae93"),m=a.PROPER,g=a.CONFIGURABLE,b=y.IteratorPrototype,_=y.BUGGY_SAFARI_ITERATORS,x=v("iterator"),w="keys",O="values",S="entries",A=function(){return this};t.exports=function(t,e,n,a,v,y,C){s(n,e,a);var E,k,j,$=function(t){if(t===v&&N)return N;if(!_&&t in P)return P[t];switch(t){case w:return function(){return new n(this,t)};case O:return function(){return new n(this,t)};case S:return function(){return new n(this,t)}}return function(){return new n(this)}},T=e+" Iterator",I=!1,P=t.prototype,L=P[x]||P["@@iterator"]||v&&P[v],N=!_&&L||$(v),D="Array"==e&&P.entries||L;if(D&&(E=u(D.call(new t)),E!==Object.prototype&&E.next&&(i||u(E)===b||(f?f(E,b):c(E[x])||p(E,x,A)),l(E,T,!0,!0),i&&(h[T]=A))),m&&v==O&&L&&L.name!==O&&(!i&&g?d(P,"name",O):(I=!0,N=function(){return o(L,this)})),v)if(k={values:$(O),keys:y?N:$(w),entries:$(S)},C)for(j in k)(_||I||!(j in P))&&p(P,j,k[j]);else r({target:e,proto:!0,forced:_||I},k);return i&&!C||P[x]===N||p(P,x,N,{name:v}),h[e]=N,k}},"7f9a":function(t,e,n){var r=n("da84"),o=n("1626"),i=n("8925"),a=r.WeakMap;t.exports=o(a)&&/native code/.test(i(a))},"825a":function(t,e,n){var r=n("da84"),o=n("861d"),i=r.String,a=r.TypeError;t.exports=function(t){if(o(t))return t;throw a(i(t)+" is not an object")}},"83ab":function(t,e,n){var r=n("d039");t.exports=!r((function(){return 7!=Object.defineProperty({},1,{get:function(){return 7}})[1]}))},8418:function(t,e,n){"use strict";var r=n("a04b"),o=n("9bf2"),i=n("5c6c");t.exports=function(t,e,n){var a=r(e);a in t?o.f(t,a,i(0,n)):t[a]=n}},"861d":function(t,e,n){var r=n("1626");t.exports=function(t){return"object"==typeof t?null!==t:r(t)}},8925:function(t,e,n){var r=n("e330"),o=n("1626"),i=n("c6cd"),a=r(Function.toString);o(i.inspectSource)||(i.inspectSource=function(t){return a(t)}),t.exports=i.inspectSource},"8aa5":function(t,e,n){"use strict";var r=n("6547").charAt;t.exports=function(t,e,n){return e+(n?r(t,e).length:1)}},"90e3":function(t,e,n){var r=n("e330"),o=0,i=Math.random(),a=r(1..toString);t.exports=function(t){return"Symbol("+(void 0===t?"":t)+")_"+a(++o+i,36)}},9112:function(t,e,n){var r=n("83ab"),o=n("9bf2"),i=n("5c6c");t.exports=r?function(t,e,n){return o.f(t,e,i(1,n))}:function(t,e,n){return t[e]=n,t}},9263:function(t,e,n){"use strict";var r=n("c65b"),o=n("e330"),i=n("577e"),a=n("ad6d"),c=n("9f7f"),s=n("5692"),u=n("7c73"),f=n("69f3").get,l=n("fce3"),d=n("107c"),p=s("native-string-replace",String.prototype.replace),v=RegExp.prototype.exec,h=v,y=o("".charAt),m=o("".indexOf),g=o("".replace),b=o("".slice),_=function(){var t=/a/,e=/b*/g;return r(v,t,"a"),r(v,e,"a"),0!==t.lastIndex||0!==e.lastIndex}(),x=c.BROKEN_CARET,w=void 0!==/()??/.exec("")[1],O=_||w||x||l||d;O&&(h=function(t){var e,n,o,c,s,l,d,O=this,S=f(O),A=i(t),C=S.raw;if(C)return C.lastIndex=O.lastIndex,e=r(h,C,A),O.lastIndex=C.lastIndex,e;var E=S.groups,k=x&&O.sticky,j=r(a,O),$=O.source,T=0,I=A;if(k&&(j=g(j,"y",""),-1===m(j,"g")&&(j+="g"),I=b(A,O.lastIndex),O.lastIndex>0&&(!O.multiline||O.multiline&&"\n"!==y(A,O.lastIndex-1))&&($="(?: "+$+")",I=" "+I,T++),n=new RegExp("^(?:"+$+")",j)),w&&(n=new RegExp("^"+$+"$(?!\\s)",j)),_&&(o=O.lastIndex),c=r(v,k?n:O,I),k?c?(c.input=b(c.input,T),c[0]=b(c[0],T),c.index=O.lastIndex,O.lastIndex+=c[0].length):O.lastIndex=0:_&&c&&(O.lastIndex=O.global?c.index+c[0].length:o),w&&c&&c.length>1&&r(p,c[0],n,(function(){for(s=1;s<arguments.length-2;s++)void 0===arguments[s]&&(c[s]=void 0)})),c&&E)for(c.groups=l=u(null),s=0;s<E.length;s++)d=E[s],l[d[0]]=c[d[1]];return c}),t.exports=h},"94ca":function(t,e,n){var r=n("d039"),o=n("1626"),i=/#|\.prototype\./,a=function(t,e){var n=s[c(t)];return n==f||n!=u&&(o(e)?r(e):!!e)},c=a.normalize=function(t){return String(t).replace(i,".").toLowerCase()},s=a.data={},u=a.NATIVE="N",f=a.POLYFILL="P";t.exports=a},"96cf":function(t,e,n){var r=function(t){"use strict";var e,n=Object.prototype,r=n.hasOwnProperty,o="function"===typeof Symbol?Symbol:{},i=o.iterator||"@@iterator",a=o.asyncIterator||"@@asyncIterator",c=o.toStringTag||"@@toStringTag";function s(t,e,n){return Object.defineProperty(t,e,{value:n,enumerable:!0,configurable:!0,writable:!0}),t[e]}try{s({},"")}catch(I){s=function(t,e,n){return t[e]=n}}function u(t,e,n,r){var o=e&&e.prototype instanceof y?e:y,i=Object.create(o.prototype),a=new j(r||[]);return i._invoke=A(t,n,a),i}function f(t,e,n){try{return{type:"normal",arg:t.call(e,n)}}catch(I){return{type:"throw",arg:I}}}t.wrap=u;var l="suspendedStart",d="suspendedYield",p="executing",v="completed",h={};function y(){}function m(){}function g(){}var b={};s(b,i,(function(){return this}));var _=Object.getPrototypeOf,x=_&&_(_($([])));x&&x!==n&&r.call(x,i)&&(b=x);var w=g.prototype=y.prototype=Object.create(b);function O(t){["next","throw","return"].forEach((function(e){s(t,e,(function(t){return this._invoke(e,t)}))}))}function S(t,e){function n(o,i,a,c){var s=f(t[o],t,i);if("throw"!==s.type){var u=s.arg,l=u.value;return l&&"object"===typeof l&&r.call(l,"__await")?e.resolve(l.__await).then((function(t){n("next",t,a,c)}),(function(t){n("throw",t,a,c)})):e.resolve(l).then((function(t){u.value=t,a(u)}),(function(t){return n("throw",t,a,c)}))}c(s.arg)}var o;function i(t,r){function i(){return new e((function(e,o){n(t,r,e,o)}))}return o=o?o.then(i,i):i()}this._invoke=i}function A(t,e,n){var r=l;return function(o,i){if(r===p)throw new Error("Generator is already running");if(r===v){if("throw"===o)throw i;return T()}n.method=o,n.arg=i;while(1){var a=n.delegate;if(a){var c=C(a,n);if(c){if(c===h)continue;return c}}if("next"===n.method)n.sent=n._sent=n.arg;else if("throw"===n.method){if(r===l)throw r=v,n.arg;n.dispatchException(n.arg)}else"return"===n.method&&n.abrupt("return",n.arg);r=p;var s=f(t,e,n);if("normal"===s.type){if(r=n.done?v:d,s.arg===h)continue;return{value:s.arg,done:n.done}}"throw"===s.type&&(r=v,n.method="throw",n.arg=s.arg)}}}function C(t,n){var r=t.iterator[n.method];if(r===e){if(n.delegate=null,"throw"===n.method){if(t.iterator["return"]&&(n.method="return",n.arg=e,C(t,n),"throw"===n.method))return h;n.method="throw",n.arg=new TypeError("The iterator does not provide a 'throw' method")}return h}var o=f(r,t.iterator,n.arg);if("throw"===o.type)return n.method="throw",n.arg=o.arg,n.delegate=null,h;var i=o.arg;return i?i.done?(n[t.resultName]=i.value,n.next=t.nextLoc,"return"!==n.method&&(n.method="next",n.arg=e),n.delegate=null,h):i:(n.method="throw",n.arg=new TypeError("iterator result is not an object"),n.delegate=null,h)}function E(t){var e={tryLoc:t[0]};1 in t&&(e.catchLoc=t[1]),2 in t&&(e.finallyLoc=t[2],e.afterLoc=t[3]),this.tryEntries.push(e)}function k(t){var e=t.completion||{};e.type="normal",delete e.arg,t.completion=e}function j(t){this.tryEntries=[{tryLoc:"root"}],t.forEach(E,this),this.reset(!0)}function $(t){if(t){var n=t[i];if(n)return n.call(t);if("function"===typeof t.next)return t;if(!isNaN(t.length)){var o=-1,a=function n(){while(++o<t.length)if(r.call(t,o))return n.value=t[o],n.done=!1,n;return n.value=e,n.done=!0,n};return a.next=a}}return{next:T}}function T(){return{value:e,done:!0}}return m.prototype=g,s(w,"constructor",g),s(g,"constructor",m),m.displayName=s(g,c,"GeneratorFunction"),t.isGeneratorFunction=function(t){var e="function"===typeof t&&t.constructor;return!!e&&(e===m||"GeneratorFunction"===(e.displayName||e.name))},t.mark=function(t){return Object.setPrototypeOf?Object.setPrototypeOf(t,g):(t.__proto__=g,s(t,c,"GeneratorFunction")),t.prototype=Object.create(w),t},t.awrap=function(t){return{__await:t}},O(S.prototype),s(S.prototype,a,(function(){return this})),t.AsyncIterator=S,t.async=function(e,n,r,o,i){void 0===i&&(i=Promise);var a=new S(u(e,n,r,o),i);return t.isGeneratorFunction(n)?a:a.next().then((function(t){return t.done?t.value:a.next()}))},O(w),s(w,c,"Generator"),s(w,i,(function(){return this})),s(w,"toString",(function(){return"[object Generator]"})),t.keys=function(t){var e=[];for(var n in t)e.push(n);return e.reverse(),function n(){while(e.length){var r=e.pop();if(r in t)return n.value=r,n.done=!1,n}return n.done=!0,n}},t.values=$,j.prototype={constructor:j,reset:function(t){if(this.prev=0,this.next=0,this.sent=this._sent=e,this.done=!1,this.delegate=null,this.method="next",this.arg=e,this.tryEntries.forEach(k),!t)for(var n in this)"t"===n.charAt(0)&&r.call(this,n)&&!isNaN(+n.slice(1))&&(this[n]=e)},stop:function(){this.done=!0;var t=this.tryEntries[0],e=t.completion;if("throw"===e.type)throw e.arg;return this.rval},dispatchException:function(t){if(this.done)throw t;var n=this;function o(r,o){return c.type="throw",c.arg=t,n.next=r,o&&(n.method="next",n.arg=e),!!o}for(var i=this.tryEntries.length-1;i>=0;--i){var a=this.tryEntries[i],c=a.completion;if("root"===a.tryLoc)return o("end");if(a.tryLoc<=this.prev){var s=r.call(a,"catchLoc"),u=r.call(a,"finallyLoc");if(s&&u){if(this.prev<a.catchLoc)return o(a.catchLoc,!0);if(this.prev<a.finallyLoc)return o(a.finallyLoc)}else if(s){if(this.prev<a.catchLoc)return o(a.catchLoc,!0)}else{if(!u)throw new Error("try statement without catch or finally");if(this.prev<a.finallyLoc)return o(a.finallyLoc)}}}},abrupt:function(t,e){for(var n=this.tryEntries.length-1;n>=0;--n){var o=this.tryEntries[n];if(o.tryLoc<=this.prev&&r.call(o,"finallyLoc")&&this.prev<o.finallyLoc){var i=o;break}}i&&("break"===t||"continue"===t)&&i.tryLoc<=e&&e<=i.finallyLoc&&(i=null);var a=i?i.completion:{};return a.type=t,a.arg=e,i?(this.method="next",this.next=i.finallyLoc,h):this.complete(a)},complete:function(t,e){if("throw"===t.type)throw t.arg;return"break"===t.type||"continue"===t.type?this.next=t.arg:"return"===t.type?(this.rval=this.arg=t.arg,this.method="return",this.next="end"):"normal"===t.type&&e&&(this.next=e),h},finish:function(t){for(var e=this.tryEntries.length-1;e>=0;--e){var n=this.tryEntries[e];if(n.finallyLoc===t)return this.complete(n.completion,n.afterLoc),k(n),h}},catch:function(t){for(var e=this.tryEntries.length-1;e>=0;--e){var n=this.tryEntries[e];if(n.tryLoc===t){var r=n.completion;if("throw"===r.type){var o=r.arg;k(n)}return o}}throw new Error("illegal catch attempt")},delegateYield:function(t,n,r){return this.delegate={iterator:$(t),resultName:n,nextLoc:r},"next"===this.method&&(this.arg=e),h}},t}(t.exports);try{regeneratorRuntime=r}catch(o){"object"===typeof globalThis?globalThis.regeneratorRuntime=r:Function("r","regeneratorRuntime = r")(r)}},"99af":function(t,e,n){"use strict";var r=n("23e7"),o=n("da84"),i=n("d039"),a=n("e8b5"),c=n("861d"),s=n("7b0b"),u=n("07fa"),f=n("8418"),l=n("65f0"),d=n("1dde"),p=n("b622"),v=n("2d00"),h=p("isConcatSpreadable"),y=9007199254740991,m="Maximum allowed index exceeded",g=o.TypeError,b=v>=51||!i((function(){var t=[];return t[h]=!1,t.concat()[0]!==t})),_=d("concat"),x=function(t){if(!c(t))return!1;var e=t[h];return void 0!==e?!!e:a(t)},w=!b||!_;r({target:"Array",proto:!0,forced:w},{concat:function(t){var e,n,r,o,i,a=s(this),c=l(a,0),d=0;for(e=-1,r=arguments.length;e<r;e++)if(i=-1===e?a:arguments[e],x(i)){if(o=u(i),d+o>y)throw g(m);for(n=0;n<o;n++,d++)n in i&&f(c,d,i[n])}else{if(d>=y)throw g(m);f(c,d++,i)}return c.length=d,c}})},"9a1f":function(t,e,n){var r=n("da84"),o=n("c65b"),i=n("59ed"),a=n("825a"),c=n("0d51"),s=n("35a1"),u=r.TypeError;t.exports=function(t,e){var n=arguments.length<2?s(t):e;if(i(n))return a(o(n,t));throw u(c(t)+" is not iterable")}},"9bdd":function(t,e,n){var r=n("825a"),o=n("2a62");t.exports=function(t,e,n,i){try{return i?e(r(n)[0],n[1]):e(n)}catch(a){o(t,"throw",a)}}},"9bf2":function(t,e,n){var r=n("da84"),o=n("83ab"),i=n("0cfb"),a=n("aed9"),c=n("825a"),s=n("a04b"),u=r.TypeError,f=Object.defineProperty,l=Object.getOwnPropertyDescriptor,d="enumerable",p="configurable",v="writable";e.f=o?a?function(t,e,n){if(c(t),e=s(e),c(n),"function"===typeof t&&"prototype"===e&&"value"in n&&v in n&&!n[v]){var r=l(t,e);r&&r[v]&&(t[e]=n.value,n={configurable:p in n?n[p]:r[p],enumerable:d in n?n[d]:r[d],writable:!1})}return f(t,e,n)}:f:function(t,e,n){if(c(t),e=s(e),c(n),i)try{return f(t,e,n)}catch(r){}if("get"in n||"set"in n)throw u("Accessors not supported");return"value"in n&&(t[e]=n.value),t}},"9ed3":function(t,e,n){"use strict";var r=n("ae93").IteratorPrototype,o=n("7c73"),i=n("5c6c"),a=n("d44e"),c=n("3f8c"),s=function(){return this};t.exports=function(t,e,n,u){var f=e+" Iterator";return t.prototype=o(r,{next:i(+!u,n)}),a(t,f,!1,!0),c[f]=s,t}},"9f7f":function(t,e,n){var r=n("d039"),o=n("da84"),i=o.RegExp,a=r((function(){var t=i("a","y");return t.lastIndex=2,null!=t.exec("abcd")})),c=a||r((function(){return!i("a","y").sticky})),s=a||r((function(){var t=i("^r","gy");return t.lastIndex=2,null!=t.exec("str")}));t.exports={BROKEN_CARET:s,MISSED_STICKY:c,UNSUPPORTED_Y:a}},a04b:function(t,e,n){var r=n("c04e"),o=n("d9b5");t.exports=function(t){var e=r(t,"string");return o(e)?e:e+""}},a4b4:function(t,e,n){var r=n("342f");t.exports=/web0s(?!.*chrome)/i.test(r)},a4d3:function(t,e,n){"use strict";var r=n("23e7"),o=n("da84"),i=n("d066"),a=n("2ba4"),c=n("c65b"),s=n("e330"),u=n("c430"),f=n("83ab"),l=n("4930"),d=n("d039"),p=n("1a2d"),v=n("e8b5"),h=n("1626"),y=n("861d"),m=n("3a9b"),g=n("d9b5"),b=n("825a"),_=n("7b0b"),x=n("fc6a"),w=n("a04b"),O=n("577e"),S=n("5c6c"),A=n("7c73"),C=n("df75"),E=n("241c"),k=n("057f"),j=n("7418"),$=n("06cf"),T=n("9bf2"),I=n("37e8"),P=n("d1e7"),L=n("f36a"),N=n("6eeb"),D=n("5692"),F=n("f772"),R=n("d012"),M=n("90e3"),U=n("b622"),V=n("e538"),B=n("746f"),G=n("d44e"),H=n("69f3"),z=n("b727").forEach,W=F("hidden"),K="Symbol",q="prototype",X=U("toPrimitive"),J=H.set,Y=H.getterFor(K),Z=Object[q],Q=o.Symbol,tt=Q&&Q[q],et=o.TypeError,nt=o.QObject,rt=i("JSON","stringify"),ot=$.f,it=T.f,at=k.f,ct=P.f,st=s([].push),ut=D("symbols"),ft=D("op-symbols"),lt=D("string-to-symbol-registry"),dt=D("symbol-to-string-registry"),pt=D("wks"),vt=!nt||!nt[q]||!nt[q].findChild,ht=f&&d((function(){return 7!=A(it({},"a",{get:function(){return it(this,"a",{value:7}).a}})).a}))?function(t,e,n){var r=ot(Z,e);r&&delete Z[e],it(t,e,n),r&&t!==Z&&it(Z,e,r)}:it,yt=function(t,e){var n=ut[t]=A(tt);return J(n,{type:K,tag:t,description:e}),f||(n.description=e),n},mt=function(t,e,n){t===Z&&mt(ft,e,n),b(t);var r=w(e);return b(n),p(ut,r)?(n.enumerable?(p(t,W)&&t[W][r]&&(t[W][r]=!1),n=A(n,{enumerable:S(0,!1)})):(p(t,W)||it(t,W,S(1,{})),t[W][r]=!0),ht(t,r,n)):it(t,r,n)},gt=function(t,e){b(t);var n=x(e),r=C(n).concat(Ot(n));return z(r,(function(e){f&&!c(_t,n,e)||mt(t,e,n[e])})),t},bt=function(t,e){return void 0===e?A(t):gt(A(t),e)},_t=function(t){var e=w(t),n=c(ct,this,e);return!(this===Z&&p(ut,e)&&!p(ft,e))&&(!(n||!p(this,e)||!p(ut,e)||p(this,W)&&this[W][e])||n)},xt=function(t,e){var n=x(t),r=w(e);if(n!==Z||!p(ut,r)||p(ft,r)){var o=ot(n,r);return!o||!p(ut,r)||p(n,W)&&n[W][r]||(o.enumerable=!0),o}},wt=function(t){var e=at(x(t)),n=[];return z(e,(function(t){p(ut,t)||p(R,t)||st(n,t)})),n},Ot=function(t){var e=t===Z,n=at(e?ft:x(t)),r=[];return z(n,(function(t){!p(ut,t)||e&&!p(Z,t)||st(r,ut[t])})),r};if(l||(Q=function(){if(m(tt,this))throw et("Symbol is not a constructor");var t=arguments.length&&void 0!==arguments[0]?O(arguments[0]):void 0,e=M(t),n=function(t){this===Z&&c(n,ft,t),p(this,W)&&p(this[W],e)&&(this[W][e]=!1),ht(this,e,S(1,t))};return f&&vt&&ht(Z,e,{configurable:!0,set:n}),yt(e,t)},tt=Q[q],N(tt,"toString",(function(){return Y(this).tag})),N(Q,"withoutSetter",(function(t){return yt(M(t),t)})),P.f=_t,T.f=mt,I.f=gt,$.f=xt,E.f=k.f=wt,j.f=Ot,V.f=function(t){return yt(U(t),t)},f&&(it(tt,"description",{configurable:!0,get:function(){return Y(this).description}}),u||N(Z,"propertyIsEnumerable",_t,{unsafe:!0}))),r({global:!0,wrap:!0,forced:!l,sham:!l},{Symbol:Q}),z(C(pt),(function(t){B(t)})),r({target:K,stat:!0,forced:!l},{for:function(t){var e=O(t);if(p(lt,e))return lt[e];var n=Q(e);return lt[e]=n,dt[n]=e,n},keyFor:function(t){if(!g(t))throw et(t+" is not a symbol");if(p(dt,t))return dt[t]},useSetter:function(){vt=!0},useSimple:function(){vt=!1}}),r({target:"Object",stat:!0,forced:!l,sham:!f},{create:bt,defineProperty:mt,defineProperties:gt,getOwnPropertyDescriptor:xt}),r({target:"Object",stat:!0,forced:!l},{getOwnPropertyNames:wt,getOwnPropertySymbols:Ot}),r({target:"Object",stat:!0,forced:d((function(){j.f(1)}))},{getOwnPropertySymbols:function(t){return j.f(_(t))}}),rt){var St=!l||d((function(){var t=Q();return"[null]"!=rt([t])||"{}"!=rt({a:t})||"{}"!=rt(Object(t))}));r({target:"JSON",stat:!0,forced:St},{stringify:function(t,e,n){var r=L(arguments),o=e;if((y(e)||void 0!==t)&&!g(t))return v(e)||(e=function(t,e){if(h(o)&&(e=c(o,this,t,e)),!g(e))return e}),r[1]=e,a(rt,null,r)}})}if(!tt[X]){var At=tt.valueOf;N(tt,X,(function(t){return c(At,this)}))}G(Q,K),R[W]=!0},a630:function(t,e,n){var r=n("23e7"),o=n("4df4"),i=n("1c7e"),a=!i((function(t){Array.from(t)}));r({target:"Array",stat:!0,forced:a},{from:o})},a640:function(t,e,n){"use strict";var r=n("d039");t.exports=function(t,e){var n=[][t];return!!n&&r((function(){n.call(null,e||function(){throw 1},1)}))}},a79d:function(t,e,n){"use strict";var r=n("23e7"),o=n("c430"),i=n("fea9"),a=n("d039"),c=n("d066"),s=n("1626"),u=n("4840"),f=n("cdf9"),l=n("6eeb"),d=!!i&&a((function(){i.prototype["finally"].call({then:function(){}},(function(){}))}));if(r({target:"Promise",proto:!0,real:!0,forced:d},{finally:function(t){var e=u(this,c("Promise")),n=s(t);return this.then(n?function(n){return f(e,t()).then((function(){return n}))}:t,n?function(n){return f(e,t()).then((function(){throw n}))}:t)}}),!o&&s(i)){var p=c("Promise").prototype["finally"];i.prototype["finally"]!==p&&l(i.prototype,"finally",p,{unsafe:!0})}},ab36:function(t,e,n){var r=n("861d"),o=n("9112");t.exports=function(t,e){r(e)&&"cause"in e&&o(t,"cause",e.cause)}},ac1f:function(t,e,n){"use strict";var r=n("23e7"),o=n("9263");r({target:"RegExp",proto:!0,forced:/./.exec!==o},{exec:o})},ad6d:function(t,e,n){"use strict";var r=n("825a");t.exports=function(){var t=r(this),e="";return t.global&&(e+="g"),t.ignoreCase&&(e+="i"),t.multiline&&(e+="m"),t.dotAll&&(e+="s"),t.unicode&&(e+="u"),t.sticky&&(e+="y"),e}},addb:function(t,e,n){var r=n("4dae"),o=Math.floor,i=function(t,e){var n=t.length,s=o(n/2);return n<8?a(t,e):c(t,i(r(t,0,s),e),i(r(t,s),e),e)},a=function(t,e){var n,r,o=t.length,i=1;while(i<o){r=i,n=t[i];while(r&&e(t[r-1],n)>0)t[r]=t[--r];r!==i++&&(t[r]=n)}return t},c=function(t,e,n,r){var o=e.length,i=n.length,a=0,c=0;while(a<o||c<i)t[a+c]=a<o&&c<i?r(e[a],n[c])<=0?e[a++]:n[c++]:a<o?e[a++]:n[c++];return t};t.exports=i},ae93:function(t,e,n){"use strict";var r,o,i,a=n("d039"),c=n("1626"),s=n("7c73"),u=n("e163"),f=n("6eeb"),l=n("b622"),d=n("c430"),p=l("iterator"),v=!1;[].keys&&(i=[].keys(),"next"in i?(o=u(u(i)),o!==Object.prototype&&(r=o)):v=!0);var h=void 0==r||a((function(){var t={};return r[p].call(t)!==t}));h?r={}:d&&(r=s(r)),c(r[p])||f(r,p,(function(){return this})),t.exports={IteratorPrototype:r,BUGGY_SAFARI_ITERATORS:v}},aed9:function(t,e,n){var r=n("83ab"),o=n("d039");t.exports=r&&o((function(){return 42!=Object.defineProperty((function(){}),"prototype",{value:42,writable:!1}).prototype}))},b041:function(t,e,n){"use strict";var r=n("00ee"),o=n("f5df");t.exports=r?{}.toString:function(){return"[object "+o(this)+"]"}},b0c0:function(t,e,n){var r=n("83ab"),o=n("5e77").EXISTS,i=n("e330"),a=n("9bf2").f,c=Function.prototype,s=i(c.toString),u=/function\b(?:\s|\/\*[\S\s]*?\*\/|\/\/[^\n\r]*[\n\r]+)*([^\s(/]*)/,f=i(u.exec),l="name";r&&!o&&a(c,l,{configurable:!0,get:function(){try{return f(u,s(this))[1]}catch(t){return""}}})},b575:function(t,e,n){var r,o,i,a,c,s,u,f,l=n("da84"),d=n("0366"),p=n("06cf").f,v=n("2cf4").set,h=n("1cdc"),y=n("d4c3"),m=n("a4b4"),g=n("605d"),b=l.MutationObserver||l.WebKitMutationObserver,_=l.document,x=l.process,w=l.Promise,O=p(l,"queueMicrotask"),S=O&&O.value;S||(r=function(){var t,e;g&&(t=x.domain)&&t.exit();while(o){e=o.fn,o=o.next;try{e()}catch(n){throw o?a():i=void 0,n}}i=void 0,t&&t.enter()},h||g||m||!b||!_?!y&&w&&w.resolve?(u=w.resolve(void 0),u.constructor=w,f=d(u.then,u),a=function(){f(r)}):g?a=function(){x.nextTick(r)}:(v=d(v,l),a=function(){v(r)}):(c=!0,s=_.createTextNode(""),new b(r).observe(s,{characterData:!0}),a=function(){s.data=c=!c})),t.exports=S||function(t){var e={fn:t,next:void 0};i&&(i.next=e),o||(o=e,a()),i=e}},b622:function(t,e,n){var r=n("da84"),o=n("5692"),i=n("1a2d"),a=n("90e3"),c=n("4930"),s=n("fdbf"),u=o("wks"),f=r.Symbol,l=f&&f["for"],d=s?f:f&&f.withoutSetter||a;t.exports=function(t){if(!i(u,t)||!c&&"string"!=typeof u[t]){var e="Symbol."+t;c&&i(f,t)?u[t]=f[t]:u[t]=s&&l?l(e):d(e)}return u[t]}},b727:function(t,e,n){var r=n("0366"),o=n("e330"),i=n("44ad"),a=n("7b0b"),c=n("07fa"),s=n("65f0"),u=o([].push),f=function(t){var e=1==t,n=2==t,o=3==t,f=4==t,l=6==t,d=7==t,p=5==t||l;return function(v,h,y,m){for(var g,b,_=a(v),x=i(_),w=r(h,y),O=c(x),S=0,A=m||s,C=e?A(v,O):n||d?A(v,0):void 0;O>S;S++)if((p||S in x)&&(g=x[S],b=w(g,S,_),t))if(e)C[S]=b;else if(b)switch(t){case 3:return!0;case 5:return g;case 6:return S;case 2:u(C,g)}else switch(t){case 4:return!1;case 7:u(C,g)}return l?-1:o||f?f:C}};t.exports={forEach:f(0),map:f(1),filter:f(2),some:f(3),every:f(4),find:f(5),findIndex:f(6),filterReject:f(7)}},b85c:function(t,e,n){"use strict";n.d(e,"a",(function(){return o}));n("a4d3"),n("e01a"),n("d3b7"),n("d28b"),n("3ca3"),n("ddb0"),n("d9e2");var r=n("06c5");function o(t,e){var n="undefined"!==typeof Symbol&&t[Symbol.iterator]||t["@@iterator"];if(!n){if(Array.isArray(t)||(n=Object(r["a"])(t))||e&&t&&"number"===typeof t.length){n&&(t=n);var o=0,i=function(){};return{s:i,n:function(){return o>=t.length?{done:!0}:{done:!1,value:t[o++]}},e:function(t){throw t},f:i}}throw new TypeError("Invalid attempt to iterate non-iterable instance.\nIn order to be iterable, non-array objects must have a [Symbol.iterator]() method.")}var a,c=!0,s=!1;return{s:function(){n=n.call(t)},n:function(){var t=n.next();return c=t.done,t},e:function(t){s=!0,a=t},f:function(){try{c||null==n["return"]||n["return"]()}finally{if(s)throw a}}}}},b980:function(t,e,n){var r=n("d039"),o=n("5c6c");t.exports=!r((function(){var t=Error("a");return!("stack"in t)||(Object.defineProperty(t,"stack",o(1,7)),7!==t.stack)}))},c04e:function(t,e,n){var r=n("da84"),o=n("c65b"),i=n("861d"),a=n("d9b5"),c=n("dc4a"),s=n("485a"),u=n("b622"),f=r.TypeError,l=u("toPrimitive");t.exports=function(t,e){if(!i(t)||a(t))return t;var n,r=c(t,l);if(r){if(void 0===e&&(e="default"),n=o(r,t,e),!i(n)||a(n))return n;throw f("Can't convert object to primitive value")}return void 0===e&&(e="number"),s(t,e)}},c430:function(t,e){t.exports=!1},c65b:function(t,e){var n=Function.prototype.call;t.exports=n.bind?n.bind(n):function(){return n.apply(n,arguments)}},c6b6:function(t,e,n){var r=n("e330"),o=r({}.toString),i=r("".slice);t.exports=function(t){return i(o(t),8,-1)}},c6cd:function(t,e,n){var r=n("da84"),o=n("ce4e"),i="__core-js_shared__",a=r[i]||o(i,{});t.exports=a},c770:function(t,e,n){var r=n("e330"),o=r("".replace),i=function(t){return String(Error(t).stack)}("zxcasd"),a=/\n\s*at [^:]*:[^\n]*/,c=a.test(i);t.exports=function(t,e){if(c&&"string"==typeof t)while(e--)t=o(t,a,"");return t}},c8ba:function(t,e){var n;n=function(){return this}();try{n=n||new Function("return this")()}catch(r){"object"===typeof window&&(n=window)}t.exports=n},ca84:function(t,e,n){var r=n("e330"),o=n("1a2d"),i=n("fc6a"),a=n("4d64").indexOf,c=n("d012"),s=r([].push);t.exports=function(t,e){var n,r=i(t),u=0,f=[];for(n in r)!o(c,n)&&o(r,n)&&s(f,n);while(e.length>u)o(r,n=e[u++])&&(~a(f,n)||s(f,n));return f}},cc12:function(t,e,n){var r=n("da84"),o=n("861d"),i=r.document,a=o(i)&&o(i.createElement);t.exports=function(t){return a?i.createElement(t):{}}},cca6:function(t,e,n){var r=n("23e7"),o=n("60da");r({target:"Object",stat:!0,forced:Object.assign!==o},{assign:o})},cdf9:function(t,e,n){var r=n("825a"),o=n("861d"),i=n("f069");t.exports=function(t,e){if(r(t),o(e)&&e.constructor===t)return e;var n=i.f(t),a=n.resolve;return a(e),n.promise}},ce4e:function(t,e,n){var r=n("da84"),o=Object.defineProperty;t.exports=function(t,e){try{o(r,t,{value:e,configurable:!0,writable:!0})}catch(n){r[t]=e}return e}},d012:function(t,e){t.exports={}},d039:function(t,e){t.exports=function(t){try{return!!t()}catch(e){return!0}}},d066:function(t,e,n){var r=n("da84"),o=n("1626"),i=function(t){return o(t)?t:void 0};t.exports=function(t,e){return arguments.length<2?i(r[t]):r[t]&&r[t][e]}},d1e7:function(t,e,n){"use strict";var r={}.propertyIsEnumerable,o=Object.getOwnPropertyDescriptor,i=o&&!r.call({1:2},1);e.f=i?function(t){var e=o(this,t);return!!e&&e.enumerable}:r},d28b:function(t,e,n){var r=n("746f");r("iterator")},d2bb:function(t,e,n){var r=n("e330"),o=n("825a"),i=n("3bbe");t.exports=Object.setPrototypeOf||("__proto__"in{}?function(){var t,e=!1,n={};try{t=r(Object.getOwnPropertyDescriptor(Object.prototype,"__proto__").set),t(n,[]),e=n instanceof Array}catch(a){}return function(n,r){return o(n),i(r),e?t(n,r):n.__proto__=r,n}}():void 0)},d3b7:function(t,e,n){var r=n("00ee"),o=n("6eeb"),i=n("b041");r||o(Object.prototype,"toString",i,{unsafe:!0})},d44e:function(t,e,n){var r=n("9bf2").f,o=n("1a2d"),i=n("b622"),a=i("toStringTag");t.exports=function(t,e,n){t&&!n&&(t=t.prototype),t&&!o(t,a)&&r(t,a,{configurable:!0,value:e})}},d4c3:function(t,e,n){var r=n("342f"),o=n("da84");t.exports=/ipad|iphone|ipod/i.test(r)&&void 0!==o.Pebble},d784:function(t,e,n){"use strict";n("ac1f");var r=n("e330"),o=n("6eeb"),i=n("9263"),a=n("d039"),c=n("b622"),s=n("9112"),u=c("species"),f=RegExp.prototype;t.exports=function(t,e,n,l){var d=c(t),p=!a((function(){var e={};return e[d]=function(){return 7},7!=""[t](e)})),v=p&&!a((function(){var e=!1,n=/a/;return"split"===t&&(n={},n.constructor={},n.constructor[u]=function(){return n},n.flags="",n[d]=/./[d]),n.exec=function(){return e=!0,null},n[d](""),!e}));if(!p||!v||n){var h=r(/./[d]),y=e(d,""[t],(function(t,e,n,o,a){var c=r(t),s=e.exec;return s===i||s===f.exec?p&&!a?{done:!0,value:h(e,n,o)}:{done:!0,value:c(n,e,o)}:{done:!1}}));o(String.prototype,t,y[0]),o(f,d,y[1])}l&&s(f[d],"sham",!0)}},d998:function(t,e,n){var r=n("342f");t.exports=/MSIE|Trident/.test(r)},d9b5:function(t,e,n){var r=n("da84"),o=n("d066"),i=n("1626"),a=n("3a9b"),c=n("fdbf"),s=r.Object;t.exports=c?function(t){return"symbol"==typeof t}:function(t){var e=o("Symbol");return i(e)&&a(e.prototype,s(t))}},d9e2:function(t,e,n){var r=n("23e7"),o=n("da84"),i=n("2ba4"),a=n("e5cb"),c="WebAssembly",s=o[c],u=7!==Error("e",{cause:7}).cause,f=function(t,e){var n={};n[t]=a(t,e,u),r({global:!0,forced:u},n)},l=function(t,e){if(s&&s[t]){var n={};n[t]=a(c+"."+t,e,u),r({target:c,stat:!0,forced:u},n)}};f("Error",(function(t){return function(e){return i(t,this,arguments)}})),f("EvalError",(function(t){return function(e){return i(t,this,arguments)}})),f("RangeError",(function(t){return function(e){return i(t,this,arguments)}})),f("ReferenceError",(function(t){return function(e){return i(t,this,arguments)}})),f("SyntaxError",(function(t){return function(e){return i(t,this,arguments)}})),f("TypeError",(function(t){return function(e){return i(t,this,arguments)}})),f("URIError",(function(t){return function(e){return i(t,this,arguments)}})),l("CompileError",(function(t){return function(e){return i(t,this,arguments)}})),l("LinkError",(function(t){return function(e){return i(t,this,arguments)}})),l("RuntimeError",(function(t){return function(e){return i(t,this,arguments)}}))},da84:function(t,e,n){(function(e){var n=function(t){return t&&t.Math==Math&&t};t.exports=n("object"==typeof globalThis&&globalThis)||n("object"==typeof window&&window)||n("object"==typeof self&&self)||n("object"==typeof e&&e)||function(){return this}()||Function("return this")()}).call(this,n("c8ba"))},dc4a:function(t,e,n){var r=n("59ed");t.exports=function(t,e){var n=t[e];return null==n?void 0:r(n)}},ddb0:function(t,e,n){var r=n("da84"),o=n("fdbc"),i=n("785a"),a=n("e260"),c=n("9112"),s=n("b622"),u=s("iterator"),f=s("toStringTag"),l=a.values,d=function(t,e){if(t){if(t[u]!==l)try{c(t,u,l)}catch(r){t[u]=l}if(t[f]||c(t,f,e),o[e])for(var n in a)if(t[n]!==a[n])try{c(t,n,a[n])}catch(r){t[n]=a[n]}}};for(var p in o)d(r[p]&&r[p].prototype,p);d(i,"DOMTokenList")},df75:function(t,e,n){var r=n("ca84"),o=n("7839");t.exports=Object.keys||function(t){return r(t,o)}},e01a:function(t,e,n){"use strict";var r=n("23e7"),o=n("83ab"),i=n("da84"),a=n("e330"),c=n("1a2d"),s=n("1626"),u=n("3a9b"),f=n("577e"),l=n("9bf2").f,d=n("e893"),p=i.Symbol,v=p&&p.prototype;if(o&&s(p)&&(!("description"in v)||void 0!==p().description)){var h={},y=function(){var t=arguments.length<1||void 0===arguments[0]?void 0:f(arguments[0]),e=u(v,this)?new p(t):void 0===t?p():p(t);return""===t&&(h[e]=!0),e};d(y,p),y.prototype=v,v.constructor=y;var m="Symbol(test)"==String(p("test")),g=a(v.toString),b=a(v.valueOf),_=/^Symbol\((.*)\)[^)]+$/,x=a("".replace),w=a("".slice);l(v,"description",{configurable:!0,get:function(){var t=b(this),e=g(t);if(c(h,t))return"";var n=m?w(e,7,-1):x(e,_,"$1");return""===n?void 0:n}}),r({global:!0,forced:!0},{Symbol:y})}},e163:function(t,e,n){var r=n("da84"),o=n("1a2d"),i=n("1626"),a=n("7b0b"),c=n("f772"),s=n("e177"),u=c("IE_PROTO"),f=r.Object,l=f.prototype;t.exports=s?f.getPrototypeOf:function(t){var e=a(t);if(o(e,u))return e[u];var n=e.constructor;return i(n)&&e instanceof n?n.prototype:e instanceof f?l:null}},e177:function(t,e,n){var r=n("d039");t.exports=!r((function(){function t(){}return t.prototype.constructor=null,Object.getPrototypeOf(new t)!==t.prototype}))},e260:function(t,e,n){"use strict";var r=n("fc6a"),o=n("44d2"),i=n("3f8c"),a=n("69f3"),c=n("9bf2").f,s=n("7dd0"),u=n("c430"),f=n("83ab"),l="Array Iterator",d=a.set,p=a.getterFor(l);t.exports=s(Array,"Array",(function(t,e){d(this,{type:l,target:r(t),index:0,kind:e})}),(function(){var t=p(this),e=t.target,n=t.kind,r=t.index++;return!e||r>=e.length?(t.target=void 0,{value:void 0,done:!0}):"keys"==n?{value:r,done:!1}:"values"==n?{value:e[r],done:!1}:{value:[r,e[r]],done:!1}}),"values");var v=i.Arguments=i.Array;if(o("keys"),o("values"),o("entries"),!u&&f&&"values"!==v.name)try{c(v,"name",{value:"values"})}catch(h){}},e2cc:function(t,e,n){var r=n("6eeb");t.exports=function(t,e,n){for(var o in e)r(t,o,e[o],n);return t}},e330:function(t,e){var n=Function.prototype,r=n.bind,o=n.call,i=r&&r.bind(o,o);t.exports=r?function(t){return t&&i(t)}:function(t){return t&&function(){return o.apply(t,arguments)}}},e391:function(t,e,n){var r=n("577e");t.exports=function(t,e){return void 0===t?arguments.length<2?"":e:r(t)}},e538:function(t,e,n){var r=n("b622");e.f=r},e5cb:function(t,e,n){"use strict";var r=n("d066"),o=n("1a2d"),i=n("9112"),a=n("3a9b"),c=n("d2bb"),s=n("e893"),u=n("7156"),f=n("e391"),l=n("ab36"),d=n("c770"),p=n("b980"),v=n("c430");t.exports=function(t,e,n,h){var y=h?2:1,m=t.split("."),g=m[m.length-1],b=r.apply(null,m);if(b){var _=b.prototype;if(!v&&o(_,"cause")&&delete _.cause,!n)return b;var x=r("Error"),w=e((function(t,e){var n=f(h?e:t,void 0),r=h?new b(t):new b;return void 0!==n&&i(r,"message",n),p&&i(r,"stack",d(r.stack,2)),this&&a(_,this)&&u(r,this,w),arguments.length>y&&l(r,arguments[y]),r}));if(w.prototype=_,"Error"!==g&&(c?c(w,x):s(w,x,{name:!0})),s(w,b),!v)try{_.name!==g&&i(_,"name",g),_.constructor=w}catch(O){}return w}}},e667:function(t,e){t.exports=function(t){try{return{error:!1,value:t()}}catch(e){return{error:!0,value:e}}}},e6cf:function(t,e,n){"use strict";var r,o,i,a,c=n("23e7"),s=n("c430"),u=n("da84"),f=n("d066"),l=n("c65b"),d=n("fea9"),p=n("6eeb"),v=n("e2cc"),h=n("d2bb"),y=n("d44e"),m=n("2626"),g=n("59ed"),b=n("1626"),_=n("861d"),x=n("19aa"),w=n("8925"),O=n("2266"),S=n("1c7e"),A=n("4840"),C=n("2cf4").set,E=n("b575"),k=n("cdf9"),j=n("44de"),$=n("f069"),T=n("e667"),I=n("01b4"),P=n("69f3"),L=n("94ca"),N=n("b622"),D=n("6069"),F=n("605d"),R=n("2d00"),M=N("species"),U="Promise",V=P.getterFor(U),B=P.set,G=P.getterFor(U),H=d&&d.prototype,z=d,W=H,K=u.TypeError,q=u.document,X=u.process,J=$.f,Y=J,Z=!!(q&&q.createEvent&&u.dispatchEvent),Q=b(u.PromiseRejectionEvent),tt="unhandledrejection",et="rejectionhandled",nt=0,rt=1,ot=2,it=1,at=2,ct=!1,st=L(U,(function(){var t=w(z),e=t!==String(z);if(!e&&66===R)return!0;if(s&&!W["finally"])return!0;if(R>=51&&/native code/.test(t))return!1;var n=new z((function(t){t(1)})),r=function(t){t((function(){}),(function(){}))},o=n.constructor={};return o[M]=r,ct=n.then((function(){}))instanceof r,!ct||!e&&D&&!Q})),ut=st||!S((function(t){z.all(t)["catch"]((function(){}))})),ft=function(t){var e;return!(!_(t)||!b(e=t.then))&&e},lt=function(t,e){var n,r,o,i=e.value,a=e.state==rt,c=a?t.ok:t.fail,s=t.resolve,u=t.reject,f=t.domain;try{c?(a||(e.rejection===at&&yt(e),e.rejection=it),!0===c?n=i:(f&&f.enter(),n=c(i),f&&(f.exit(),o=!0)),n===t.promise?u(K("Promise-chain cycle")):(r=ft(n))?l(r,n,s,u):s(n)):u(i)}catch(d){f&&!o&&f.exit(),u(d)}},dt=function(t,e){t.notified||(t.notified=!0,E((function(){var n,r=t.reactions;while(n=r.get())lt(n,t);t.notified=!1,e&&!t.rejection&&vt(t)})))},pt=function(t,e,n){var r,o;Z?(r=q.createEvent("Event"),r.promise=e,r.reason=n,r.initEvent(t,!1,!0),u.dispatchEvent(r)):r={promise:e,reason:n},!Q&&(o=u["on"+t])?o(r):t===tt&&j("Unhandled promise rejection",n)},vt=function(t){l(C,u,(function(){var e,n=t.facade,r=t.value,o=ht(t);if(o&&(e=T((function(){F?X.emit("unhandledRejection",r,n):pt(tt,n,r)})),t.rejection=F||ht(t)?at:it,e.error))throw e.value}))},ht=function(t){return t.rejection!==it&&!t.parent},yt=function(t){l(C,u,(function(){var e=t.facade;F?X.emit("rejectionHandled",e):pt(et,e,t.value)}))},mt=function(t,e,n){return function(r){t(e,r,n)}},gt=function(t,e,n){t.done||(t.done=!0,n&&(t=n),t.value=e,t.state=ot,dt(t,!0))},bt=function(t,e,n){if(!t.done){t.done=!0,n&&(t=n);try{if(t.facade===e)throw K("Promise can't be resolved itself");var r=ft(e);r?E((function(){var n={done:!1};try{l(r,e,mt(bt,n,t),mt(gt,n,t))}catch(o){gt(n,o,t)}})):(t.value=e,t.state=rt,dt(t,!1))}catch(o){gt({done:!1},o,t)}}};if(st&&(z=function(t){x(this,W),g(t),l(r,this);var e=V(this);try{t(mt(bt,e),mt(gt,e))}catch(n){gt(e,n)}},W=z.prototype,r=function(t){B(this,{type:U,done:!1,notified:!1,parent:!1,reactions:new I,rejection:!1,state:nt,value:void 0})},r.prototype=v(W,{then:function(t,e){var n=G(this),r=J(A(this,z));return n.parent=!0,r.ok=!b(t)||t,r.fail=b(e)&&e,r.domain=F?X.domain:void 0,n.state==nt?n.reactions.add(r):E((function(){lt(r,n)})),r.promise},catch:function(t){return this.then(void 0,t)}}),o=function(){var t=new r,e=V(t);this.promise=t,this.resolve=mt(bt,e),this.reject=mt(gt,e)},$.f=J=function(t){return t===z||t===i?new o(t):Y(t)},!s&&b(d)&&H!==Object.prototype)){a=H.then,ct||(p(H,"then",(function(t,e){var n=this;return new z((function(t,e){l(a,n,t,e)})).then(t,e)}),{unsafe:!0}),p(H,"catch",W["catch"],{unsafe:!0}));try{delete H.constructor}catch(_t){}h&&h(H,W)}c({global:!0,wrap:!0,forced:st},{Promise:z}),y(z,U,!1,!0),m(U),i=f(U),c({target:U,stat:!0,forced:st},{reject:function(t){var e=J(this);return l(e.reject,void 0,t),e.promise}}),c({target:U,stat:!0,forced:s||st},{resolve:function(t){return k(s&&this===i?z:this,t)}}),c({target:U,stat:!0,forced:ut},{all:function(t){var e=this,n=J(e),r=n.resolve,o=n.reject,i=T((function(){var n=g(e.resolve),i=[],a=0,c=1;O(t,(function(t){var s=a++,u=!1;c++,l(n,e,t).then((function(t){u||(u=!0,i[s]=t,--c||r(i))}),o)})),--c||r(i)}));return i.error&&o(i.value),n.promise},race:function(t){var e=this,n=J(e),r=n.reject,o=T((function(){var o=g(e.resolve);O(t,(function(t){l(o,e,t).then(n.resolve,r)}))}));return o.error&&r(o.value),n.promise}})},e893:function(t,e,n){var r=n("1a2d"),o=n("56ef"),i=n("06cf"),a=n("9bf2");t.exports=function(t,e,n){for(var c=o(e),s=a.f,u=i.f,f=0;f<c.length;f++){var l=c[f];r(t,l)||n&&r(n,l)||s(t,l,u(e,l))}}},e8b5:function(t,e,n){var r=n("c6b6");t.exports=Array.isArray||function(t){return"Array"==r(t)}},e95a:function(t,e,n){var r=n("b622"),o=n("3f8c"),i=r("iterator"),a=Array.prototype;t.exports=function(t){return void 0!==t&&(o.Array===t||a[i]===t)}},e9c4:function(t,e,n){var r=n("23e7"),o=n("da84"),i=n("d066"),a=n("2ba4"),c=n("e330"),s=n("d039"),u=o.Array,f=i("JSON","stringify"),l=c(/./.exec),d=c("".charAt),p=c("".charCodeAt),v=c("".replace),h=c(1..toString),y=/[\uD800-\uDFFF]/g,m=/^[\uD800-\uDBFF]$/,g=/^[\uDC00-\uDFFF]$/,b=function(t,e,n){var r=d(n,e-1),o=d(n,e+1);return l(m,t)&&!l(g,o)||l(g,t)&&!l(m,r)?"\\u"+h(p(t,0),16):t},_=s((function(){return'"\\udf06\\ud834"'!==f("\udf06\ud834")||'"\\udead"'!==f("\udead")}));f&&r({target:"JSON",stat:!0,forced:_},{stringify:function(t,e,n){for(var r=0,o=arguments.length,i=u(o);r<o;r++)i[r]=arguments[r];var c=a(f,null,i);return"string"==typeof c?v(c,y,b):c}})},f069:function(t,e,n){"use strict";var r=n("59ed"),o=function(t){var e,n;this.promise=new t((function(t,r){if(void 0!==e||void 0!==n)throw TypeError("Bad Promise constructor");e=t,n=r})),this.resolve=r(e),this.reject=r(n)};t.exports.f=function(t){return new o(t)}},f36a:function(t,e,n){var r=n("e330");t.exports=r([].slice)},f5df:function(t,e,n){var r=n("da84"),o=n("00ee"),i=n("1626"),a=n("c6b6"),c=n("b622"),s=c("toStringTag"),u=r.Object,f="Arguments"==a(function(){return arguments}()),l=function(t,e){try{return t[e]}catch(n){}};t.exports=o?a:function(t){var e,n,r;return void 0===t?"Undefined":null===t?"Null":"string"==typeof(n=l(e=u(t),s))?n:f?a(e):"Object"==(r=a(e))&&i(e.callee)?"Arguments":r}},f772:function(t,e,n){var r=n("5692"),o=n("90e3"),i=r("keys");t.exports=function(t){return i[t]||(i[t]=o(t))}},fb6a:function(t,e,n){"use strict";var r=n("23e7"),o=n("da84"),i=n("e8b5"),a=n("68ee"),c=n("861d"),s=n("23cb"),u=n("07fa"),f=n("fc6a"),l=n("8418"),d=n("b622"),p=n("1dde"),v=n("f36a"),h=p("slice"),y=d("species"),m=o.Array,g=Math.max;r({target:"Array",proto:!0,forced:!h},{slice:function(t,e){var n,r,o,d=f(this),p=u(d),h=s(t,p),b=s(void 0===e?p:e,p);if(i(d)&&(n=d.constructor,a(n)&&(n===m||i(n.prototype))?n=void 0:c(n)&&(n=n[y],null===n&&(n=void 0)),n===m||void 0===n))return v(d,h,b);for(r=new(void 0===n?m:n)(g(b-h,0)),o=0;h<b;h++,o++)h in d&&l(r,o,d[h]);return r.length=o,r}})},fc6a:function(t,e,n){var r=n("44ad"),o=n("1d80");t.exports=function(t){return r(o(t))}},fce3:function(t,e,n){var r=n("d039"),o=n("da84"),i=o.RegExp;t.exports=r((function(){var t=i(".","s");return!(t.dotAll&&t.exec("\n")&&"s"===t.flags)}))},fdbc:function(t,e){t.exports={CSSRuleList:0,CSSStyleDeclaration:0,CSSValueList:0,ClientRectList:0,DOMRectList:0,DOMStringList:0,DOMTokenList:1,DataTransferItemList:0,FileList:0,HTMLAllCollection:0,HTMLCollection:0,HTMLFormElement:0,HTMLSelectElement:0,MediaList:0,MimeTypeArray:0,NamedNodeMap:0,NodeList:1,PaintRequestList:0,Plugin:0,PluginArray:0,SVGLengthList:0,SVGNumberList:0,SVGPathSegList:0,SVGPointList:0,SVGStringList:0,SVGTransformList:0,SourceBufferList:0,StyleSheetList:0,TextTrackCueList:0,TextTrackList:0,TouchList:0}},fdbf:function(t,e,n){var r=n("4930");t.exports=r&&!Symbol.sham&&"symbol"==typeof Symbol.iterator},fea9:function(t,e,n){var r=n("da84");t.exports=r.Promise}}]);
-//# sourceMappingURL=chunk-vendors-legacy.025df477.js.map
\ No newline at end of file
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"net"
+	"os"
+	"sort"
+	"sync"
+)
+
+// RunValidate checks the hosts file for errors and prints each with the file and line it's at.
+// It returns the exit code: 1 if there are errors, or 2 if the arguments are invalid
+func RunValidate(args []string) int {
+	config, err := LoadConfig()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 2
+	}
+
+	flags := flag.NewFlagSet("validate", flag.ContinueOnError)
+	maxExpand := flags.Int("max-expand", config.MaxExpand, "maximum amount of addresses a host can expand to")
+	resolveHosts := flags.Bool("resolve", true, "check that every hostname resolves")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: %s validate [flags] [hosts file]\n\n", os.Args[0])
+		fmt.Fprintln(flags.Output(), "Checks the hosts file (default HOSTSPATH) and the files it includes for errors and unknown fields. Flags:")
+		flags.PrintDefaults()
+	}
+	if err = flags.Parse(args); err != nil {
+		return 2
+	}
+
+	path := config.HostsPath
+	if flags.NArg() > 1 {
+		flags.Usage()
+		return 2
+	} else if flags.NArg() == 1 {
+		path = flags.Arg(0)
+	}
+	if path == "" {
+		fmt.Fprintln(os.Stderr, "no hosts file given and HOSTSPATH isn't set")
+		return 2
+	}
+
+	l := newSchemaLoader(*maxExpand)
+	l.strict = true
+	s, err := l.loadSchema(path)
+	if err != nil {
+		printErrors(err)
+		return 1
+	}
+
+	// hosts can be in more than one category of a file, but it's usually a mistake
+	categories := s.Categories()
+	first := make(map[string]*Category)
+	warnings := make(schemaErrors, 0)
+	for _, c := range categories {
+		for _, h := range c.Hosts {
+			if fc, ok := first[h.Host]; ok {
+				warnings = append(warnings, l.locate(&entryError{Category: c, Host: h.Host, Err: fmt.Errorf("also in category %q", fc.Path)}))
+				continue
+			}
+			first[h.Host] = c
+		}
+	}
+	for _, w := range warnings {
+		fmt.Println("warning:", w)
+	}
+
+	errs := make(schemaErrors, 0)
+	if *resolveHosts {
+		resolver := NewResolver(config.Resolvers, config.QueueSize)
+		mu := new(sync.Mutex)
+		wg := new(sync.WaitGroup)
+		for _, c := range categories {
+			for _, h := range c.Hosts {
+				if first[h.Host] != c || net.ParseIP(h.Host) != nil {
+					continue
+				}
+				c, h := c, h
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					if _, err := resolver.LookupIP(h.Host, *h.Family); err != nil {
+						mu.Lock()
+						errs = append(errs, l.locate(&entryError{Category: c, Host: h.Host, Err: fmt.Errorf("could not resolve: %w", err)}))
+						mu.Unlock()
+					}
+				}()
+			}
+		}
+		wg.Wait()
+		sort.Slice(errs, func(i, j int) bool { return errs[i].Error() < errs[j].Error() })
+	}
+
+	for _, err := range errs {
+		fmt.Println(err)
+	}
+
+	fmt.Printf("%s: %d categories, %d hosts, %d errors, %d warnings\n", path, len(categories), len(first), len(errs), len(warnings))
+	if len(errs) > 0 {
+		return 1
+	}
+	return 0
+}
+
+// printErrors prints each error in err on its own line
+func printErrors(err error) {
+	errs := make(schemaErrors, 0)
+	if !errors.As(err, &errs) {
+		fmt.Println(err)
+		return
+	}
+	for _, e := range errs {
+		fmt.Println(e)
+	}
+	fmt.Printf("%d errors\n", len(errs))
+}
@@ -0,0 +1,169 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// SchemaDiff is an incremental schema change sent to websocket clients. Order is the new category tree without hosts,
+// Categories are the new host lists of categories that were added or whose hosts changed, keyed by path, and Changed are hosts whose options changed
+type SchemaDiff struct {
+	Order      []*CategoryOrder   `json:"o"`
+	Categories map[string][]*Host `json:"c"`
+	Changed    []*Host            `json:"u"`
+}
+
+// CategoryOrder is a category and its nested categories in a SchemaDiff
+type CategoryOrder struct {
+	Category   string           `json:"category"`
+	Path       string           `json:"path"`
+	Categories []*CategoryOrder `json:"categories,omitempty"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (d *SchemaDiff) MarshalJSON() ([]byte, error) {
+	type diff SchemaDiff
+	return json.Marshal(&struct {
+		Type string `json:"t"`
+		*diff
+	}{Type: "d", diff: (*diff)(d)})
+}
+
+// categoryOrder returns the tree of categories without hosts
+func categoryOrder(categories []*Category) []*CategoryOrder {
+	order := make([]*CategoryOrder, 0, len(categories))
+	for _, c := range categories {
+		order = append(order, &CategoryOrder{Category: c.Category, Path: c.Path, Categories: categoryOrder(c.Categories)})
+	}
+	return order
+}
+
+// categoryHosts returns the names of c's hosts
+func categoryHosts(c *Category) []string {
+	hosts := make([]string, 0, len(c.Hosts))
+	for _, h := range c.Hosts {
+		hosts = append(hosts, h.Host)
+	}
+	return hosts
+}
+
+// DiffSchema returns the changes from old to new. It returns false if a diff can't be used, i.e. category paths aren't unique
+func DiffSchema(old, new Schema) (*SchemaDiff, bool) {
+	oldCats := make(map[string]*Category)
+	oldHosts := make(map[string]*Host)
+	for _, c := range old.Categories() {
+		if _, ok := oldCats[c.Path]; ok {
+			return nil, false
+		}
+		oldCats[c.Path] = c
+		for _, h := range c.Hosts {
+			oldHosts[h.Host] = h
+		}
+	}
+
+	d := &SchemaDiff{Order: categoryOrder(new), Categories: make(map[string][]*Host), Changed: make([]*Host, 0)}
+	seenCats := make(map[string]struct{})
+	seenHosts := make(map[string]struct{})
+	for _, c := range new.Categories() {
+		if _, ok := seenCats[c.Path]; ok {
+			return nil, false
+		}
+		seenCats[c.Path] = struct{}{}
+
+		if oc, ok := oldCats[c.Path]; !ok || !reflect.DeepEqual(categoryHosts(oc), categoryHosts(c)) {
+			d.Categories[c.Path] = c.Hosts
+		}
+
+		for _, h := range c.Hosts {
+			if _, ok := seenHosts[h.Host]; ok {
+				continue
+			}
+			seenHosts[h.Host] = struct{}{}
+			if oh, ok := oldHosts[h.Host]; ok && !reflect.DeepEqual(oh, h) {
+				d.Changed = append(d.Changed, h)
+			}
+		}
+	}
+
+	return d, true
+}
+
+// SchemaError is sent to websocket clients when the hosts file can't be loaded. An empty Error means the last error was resolved
+type SchemaError struct {
+	Error error
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (e *SchemaError) MarshalJSON() ([]byte, error) {
+	type schemaError struct {
+		Type  string `json:"t"`
+		Error string `json:"e,omitempty"`
+	}
+
+	se := &schemaError{Type: "y"}
+	if e.Error != nil {
+		se.Error = e.Error.Error()
+	}
+	return json.Marshal(se)
+}
+
+// fingerprint returns a string that changes when any of the files at paths are modified
+func fingerprint(paths []string) string {
+	parts := make([]string, 0, len(paths))
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			parts = append(parts, path+":"+err.Error())
+			continue
+		}
+		parts = append(parts, fmt.Sprintf("%s:%d:%d", path, info.Size(), info.ModTime().UnixNano()))
+	}
+	return strings.Join(parts, "\n")
+}
+
+// reloadSchema loads the hosts file and swaps in the new schema, or keeps the current schema and broadcasts the error.
+// It returns the files and directories the schema was loaded from, and true if the schema changed
+func (s *Service) reloadSchema() ([]string, bool) {
+	schema, paths, err := LoadSchema(s.Config.HostsPath, s.Config.MaxExpand)
+	if err != nil {
+		log.Println("could not reload schema, keeping current schema:", err)
+		s.State.SetSchemaError(err)
+		return paths, false
+	}
+
+	s.State.SetSchemaError(nil)
+	return paths, s.State.SetSchema(schema)
+}
+
+// Watch polls paths, the files and directories the schema was loaded from, every Config.WatchInterval and reloads the schema when they change,
+// triggering a scan. Watch never returns
+func (s *Service) Watch(paths []string) {
+	last := fingerprint(paths)
+	for {
+		time.Sleep(s.Config.WatchInterval)
+		fp := fingerprint(paths)
+		if fp == last {
+			continue
+		}
+		last = fp
+
+		log.Println("hosts file changed, reloading")
+		newPaths, changed := s.reloadSchema()
+		// files may have been included or removed
+		if !reflect.DeepEqual(paths, newPaths) {
+			paths = newPaths
+			last = fingerprint(paths)
+		}
+		if changed {
+			select {
+			case s.rescan <- struct{}{}:
+			default:
+			}
+		}
+	}
+}
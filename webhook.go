@@ -0,0 +1,172 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// Webhook defaults
+const (
+	defaultWebhookRetries = 3
+	defaultWebhookBackoff = time.Second
+	defaultWebhookTimeout = 10 * time.Second
+)
+
+// webhookFuncs are the functions available to webhook templates
+var webhookFuncs = template.FuncMap{
+	"json": func(v interface{}) (string, error) {
+		buf, err := json.Marshal(v)
+		return string(buf), err
+	},
+	"join": strings.Join,
+}
+
+// Webhook sends alerts as HTTP requests. If Categories is empty, alerts for all categories are sent.
+// Template is a text/template executed with an *Alert; if it's empty, the Alert is sent as JSON.
+// Failed requests are retried up to Retries times, waiting Backoff and doubling it after each attempt
+type Webhook struct {
+	WebhookName string            `yaml:"name"`
+	URL         string            `yaml:"url"`
+	Method      string            `yaml:"method"`
+	Headers     map[string]string `yaml:"headers"`
+	Categories  []string          `yaml:"categories"`
+	Template    string            `yaml:"template"`
+	Retries     *int              `yaml:"retries"`
+	Backoff     time.Duration     `yaml:"backoff"`
+	Timeout     time.Duration     `yaml:"timeout"`
+
+	tmpl   *template.Template
+	client *http.Client
+}
+
+// Validate returns an error if the webhook is invalid, and applies defaults
+func (w *Webhook) Validate() error {
+	if !strings.HasPrefix(w.URL, "http://") && !strings.HasPrefix(w.URL, "https://") {
+		return fmt.Errorf("invalid url %q: must start with http:// or https://", w.URL)
+	}
+
+	if w.Method == "" {
+		w.Method = http.MethodPost
+	}
+	w.Method = strings.ToUpper(w.Method)
+
+	if w.Retries == nil {
+		retries := defaultWebhookRetries
+		w.Retries = &retries
+	} else if *w.Retries < 0 {
+		return errors.New("retries must not be negative")
+	}
+	if w.Backoff == 0 {
+		w.Backoff = defaultWebhookBackoff
+	}
+	if w.Timeout == 0 {
+		w.Timeout = defaultWebhookTimeout
+	}
+	w.client = &http.Client{Timeout: w.Timeout}
+
+	if w.Template != "" {
+		tmpl, err := template.New(w.Name()).Funcs(webhookFuncs).Parse(w.Template)
+		if err != nil {
+			return fmt.Errorf("invalid template: %w", err)
+		}
+		w.tmpl = tmpl
+	}
+
+	return nil
+}
+
+// Name returns the name of the webhook, or its URL if it doesn't have one
+func (w *Webhook) Name() string {
+	if w.WebhookName != "" {
+		return w.WebhookName
+	}
+	return w.URL
+}
+
+// Routes implements the Notifier interface
+func (w *Webhook) Routes() []string {
+	return w.Categories
+}
+
+// Notify implements the Notifier interface
+func (w *Webhook) Notify(alert *Alert) {
+	go func() {
+		if err := w.Send(alert); err != nil {
+			log.Printf("could not send alert for %s to webhook %s: %v", alert.Host, w.Name(), err)
+		}
+	}()
+}
+
+// Payload returns the request body for alert
+func (w *Webhook) Payload(alert *Alert) ([]byte, error) {
+	if w.tmpl == nil {
+		return json.Marshal(alert)
+	}
+
+	buf := new(bytes.Buffer)
+	if err := w.tmpl.Execute(buf, alert); err != nil {
+		return nil, fmt.Errorf("could not execute template: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// Send sends alert to the webhook, retrying on failure
+func (w *Webhook) Send(alert *Alert) error {
+	payload, err := w.Payload(alert)
+	if err != nil {
+		return err
+	}
+
+	backoff := w.Backoff
+	for attempt := 0; ; attempt++ {
+		retry, err := w.send(payload)
+		if err == nil {
+			return nil
+		}
+		if !retry || attempt >= *w.Retries {
+			return fmt.Errorf("failed after %d attempt(s): %w", attempt+1, err)
+		}
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+}
+
+// send makes a single request and returns whether it should be retried if it failed
+func (w *Webhook) send(payload []byte) (retry bool, err error) {
+	req, err := http.NewRequest(w.Method, w.URL, bytes.NewReader(payload))
+	if err != nil {
+		return false, fmt.Errorf("could not create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range w.Headers {
+		if strings.EqualFold(k, "Host") {
+			req.Host = v
+			continue
+		}
+		req.Header.Set(k, v)
+	}
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return true, fmt.Errorf("could not complete request: %w", err)
+	}
+	defer resp.Body.Close()
+	// the body is only read so the connection can be reused
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxHTTPBody))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		// client errors other than rate limiting won't succeed on retry
+		retry = resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests
+		return retry, fmt.Errorf("unexpected status: %s", resp.Status)
+	}
+
+	return false, nil
+}
@@ -0,0 +1,198 @@
+package main
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// webhookReceiver records the requests it receives, responding with statuses in order and 200 after they run out
+type webhookReceiver struct {
+	mu       sync.Mutex
+	statuses []int
+	requests []*http.Request
+	bodies   []string
+	times    []time.Time
+}
+
+func (rc *webhookReceiver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	rc.requests = append(rc.requests, r)
+	rc.bodies = append(rc.bodies, string(body))
+	rc.times = append(rc.times, time.Now())
+
+	status := http.StatusOK
+	if len(rc.statuses) > 0 {
+		status, rc.statuses = rc.statuses[0], rc.statuses[1:]
+	}
+	w.WriteHeader(status)
+}
+
+func newWebhookReceiver(t *testing.T, statuses ...int) (*webhookReceiver, *httptest.Server) {
+	t.Helper()
+	rc := &webhookReceiver{statuses: statuses}
+	srv := httptest.NewServer(rc)
+	t.Cleanup(srv.Close)
+	return rc, srv
+}
+
+func testAlert() *Alert {
+	return &Alert{
+		Host:       "example.com",
+		Category:   "Servers",
+		Categories: []string{"Servers"},
+		Matched:    []string{"Servers"},
+		IPs:        []string{"192.0.2.1"},
+		State:      StatusDown,
+		Previous:   StatusUp,
+		Time:       time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC),
+		Duration:   90 * time.Second,
+		Tags:       []string{"web", "prod"},
+	}
+}
+
+func TestWebhookRetries(t *testing.T) {
+	retries := func(n int) *int { return &n }
+
+	tests := []struct {
+		name     string
+		statuses []int
+		retries  *int
+		attempts int
+		err      string
+	}{
+		{name: "success", attempts: 1},
+		{name: "retry server error", statuses: []int{500, 502}, attempts: 3},
+		{name: "retry rate limit", statuses: []int{429}, attempts: 2},
+		{name: "no retry on client error", statuses: []int{400}, attempts: 1, err: "failed after 1 attempt(s): unexpected status: 400"},
+		{name: "retries exhausted", statuses: []int{500, 500, 500}, retries: retries(2), attempts: 3, err: "failed after 3 attempt(s): unexpected status: 500"},
+		{name: "no retries", statuses: []int{503}, retries: retries(0), attempts: 1, err: "failed after 1 attempt(s)"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rc, srv := newWebhookReceiver(t, test.statuses...)
+			w := &Webhook{URL: srv.URL, Retries: test.retries, Backoff: 20 * time.Millisecond}
+			if err := w.Validate(); err != nil {
+				t.Fatalf("could not validate webhook: %v", err)
+			}
+
+			err := w.Send(testAlert())
+			switch {
+			case test.err == "" && err != nil:
+				t.Errorf("unexpected error: %v", err)
+			case test.err != "" && err == nil:
+				t.Errorf("expected error %q", test.err)
+			case test.err != "" && !strings.Contains(err.Error(), test.err):
+				t.Errorf("error = %q, want %q", err, test.err)
+			}
+
+			if len(rc.requests) != test.attempts {
+				t.Fatalf("attempts = %d, want %d", len(rc.requests), test.attempts)
+			}
+
+			// each wait is at least double the backoff before it
+			want := w.Backoff
+			for i := 1; i < len(rc.times); i++ {
+				if wait := rc.times[i].Sub(rc.times[i-1]); wait < want {
+					t.Errorf("wait before attempt %d = %v, want at least %v", i+1, wait, want)
+				}
+				want *= 2
+			}
+		})
+	}
+}
+
+func TestWebhookPayload(t *testing.T) {
+	rc, srv := newWebhookReceiver(t)
+	w := &Webhook{URL: srv.URL}
+	if err := w.Validate(); err != nil {
+		t.Fatalf("could not validate webhook: %v", err)
+	}
+	if err := w.Send(testAlert()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	r := rc.requests[0]
+	if r.Method != http.MethodPost {
+		t.Errorf("method = %s, want POST", r.Method)
+	}
+	if ct := r.Header.Get("Content-Type"); ct != "application/json" {
+		t.Errorf("content type = %q, want application/json", ct)
+	}
+
+	var body map[string]interface{}
+	if err := json.Unmarshal([]byte(rc.bodies[0]), &body); err != nil {
+		t.Fatalf("could not parse body: %v", err)
+	}
+	if body["host"] != "example.com" || body["state"] != "down" || body["duration"] != float64(90) {
+		t.Errorf("unexpected body: %s", rc.bodies[0])
+	}
+}
+
+func TestWebhookTemplate(t *testing.T) {
+	rc, srv := newWebhookReceiver(t)
+	w := &Webhook{
+		URL:      srv.URL + "/hook",
+		Method:   "put",
+		Headers:  map[string]string{"Host": "hooks.example.com", "Authorization": "Bearer secret"},
+		Template: `{"text": {{ printf "%s is %s" .Host .State | json }}, "tags": {{ join .Tags "," | json }}}`,
+	}
+	if err := w.Validate(); err != nil {
+		t.Fatalf("could not validate webhook: %v", err)
+	}
+	if err := w.Send(testAlert()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	r := rc.requests[0]
+	if r.Method != http.MethodPut {
+		t.Errorf("method = %s, want PUT", r.Method)
+	}
+	if r.URL.Path != "/hook" {
+		t.Errorf("path = %s, want /hook", r.URL.Path)
+	}
+	if r.Host != "hooks.example.com" {
+		t.Errorf("host = %s, want hooks.example.com", r.Host)
+	}
+	if auth := r.Header.Get("Authorization"); auth != "Bearer secret" {
+		t.Errorf("authorization = %q, want %q", auth, "Bearer secret")
+	}
+	if want := `{"text": "example.com is down", "tags": "web,prod"}`; rc.bodies[0] != want {
+		t.Errorf("body = %s, want %s", rc.bodies[0], want)
+	}
+}
+
+func TestWebhookValidate(t *testing.T) {
+	negative := -1
+
+	tests := []struct {
+		name    string
+		webhook *Webhook
+		valid   bool
+	}{
+		{name: "valid", webhook: &Webhook{URL: "https://hooks.example.com/", Template: "{{ .Host }}"}, valid: true},
+		{name: "bad scheme", webhook: &Webhook{URL: "hooks.example.com"}},
+		{name: "negative retries", webhook: &Webhook{URL: "https://hooks.example.com/", Retries: &negative}},
+		{name: "bad template", webhook: &Webhook{URL: "https://hooks.example.com/", Template: "{{ .Host "}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.webhook.Validate()
+			if test.valid && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			} else if !test.valid && err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}